@@ -0,0 +1,457 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"golang.org/x/crypto/nacl/box"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+)
+
+// Scopes Actions secrets and variables can be published at.
+const (
+	scopeRepository   = "repository"
+	scopeEnvironment  = "environment"
+	scopeOrganization = "organization"
+)
+
+var actionsSecretQueue = newQueue("actionssecrets")
+
+// actionsNameReplacer turns Secret and ConfigMap keys into valid Actions
+// secret and variable names.
+var actionsNameReplacer = strings.NewReplacer("-", "_", ".", "_")
+
+// watchActionsSecretSources re-enqueues ActionsSecrets whenever the Secret or
+// ConfigMap they publish changes, so that Github is kept up to date without
+// having to touch the ActionsSecret itself. It returns the HasSynced functions
+// of the informers it uses.
+func watchActionsSecretSources() []cache.InformerSynced {
+	var synced []cache.InformerSynced
+	for _, secrets := range kubeInformersOf("secrets") {
+		secrets.AddEventHandler(sourceHandler(func(namespace, name string) {
+			enqueueActionsSecrets(namespace, func(spec v1.ActionsSecretSpec) bool {
+				return spec.SecretName == name
+			})
+		}))
+		synced = append(synced, secrets.HasSynced)
+	}
+
+	for _, configMaps := range kubeInformersOf("configmaps") {
+		configMaps.AddEventHandler(sourceHandler(func(namespace, name string) {
+			enqueueActionsSecrets(namespace, func(spec v1.ActionsSecretSpec) bool {
+				return spec.ConfigMapName == name
+			})
+		}))
+		synced = append(synced, configMaps.HasSynced)
+	}
+
+	return synced
+}
+
+// enqueueActionsSecrets enqueues the ActionsSecrets in 'namespace' whose spec
+// matches.
+func enqueueActionsSecrets(namespace string, matches func(spec v1.ActionsSecretSpec) bool) {
+	actionsSecrets, err := informersFor(namespace).Github().V1().ActionsSecrets().Lister().ActionsSecrets(namespace).List(labels.Everything())
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("error listing ActionsSecrets in %q: %w", namespace, err))
+		return
+	}
+	for _, actionsSecret := range actionsSecrets {
+		if matches(actionsSecret.Spec) {
+			enqueue(actionsSecretQueue, actionsSecret)
+		}
+	}
+}
+
+// processActionsSecret retrieves the latest version of the ActionsSecret
+// 'namespace/name' from the cache and syncs it.
+func processActionsSecret(namespace, name string) error {
+	obj, err := informersFor(namespace).Github().V1().ActionsSecrets().Lister().ActionsSecrets(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		// deleted, the secret on Github is kept.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting object '%s/%s' from api: %w", namespace, name, err)
+	}
+
+	return syncActionsSecret(obj.DeepCopy())
+}
+
+// syncActionsSecret publishes the selected Secret and ConfigMap keys to
+// Github. Values whose hash matches the one recorded in status are skipped,
+// and names that are no longer published are deleted from Github.
+func syncActionsSecret(actionsSecret *v1.ActionsSecret) error {
+	target, err := newActionsTarget(actionsSecret.Spec)
+	if err != nil {
+		return err
+	}
+
+	secrets, variables, err := actionsSecretValues(actionsSecret)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	status := v1.ActionsSecretStatus{}
+	if len(secrets) > 0 {
+		status.Secrets = map[string]string{}
+	}
+	if len(variables) > 0 {
+		status.Variables = map[string]string{}
+	}
+
+	for name, value := range secrets {
+		hash := actionsValueHash(actionsSecret, name, value)
+		if actionsSecret.Status.Secrets[name] != hash {
+			if err := target.putSecret(name, value); err != nil {
+				errs = append(errs, fmt.Errorf("error publishing secret %s: %w", name, err))
+				continue
+			}
+			klog.InfoS("Published secret", "actionsSecret", klog.KObj(actionsSecret), "secret", name)
+		}
+		status.Secrets[name] = hash
+	}
+	for name, hash := range actionsSecret.Status.Secrets {
+		if _, ok := secrets[name]; ok {
+			continue
+		}
+		if err := target.deleteSecret(name); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting secret %s: %w", name, err))
+			// keep tracking it so that the deletion is retried
+			if status.Secrets == nil {
+				status.Secrets = map[string]string{}
+			}
+			status.Secrets[name] = hash
+		}
+	}
+
+	for name, value := range variables {
+		hash := actionsValueHash(actionsSecret, name, []byte(value))
+		if actionsSecret.Status.Variables[name] != hash {
+			if err := target.putVariable(name, value); err != nil {
+				errs = append(errs, fmt.Errorf("error publishing variable %s: %w", name, err))
+				continue
+			}
+			klog.InfoS("Published variable", "actionsSecret", klog.KObj(actionsSecret), "variable", name)
+		}
+		status.Variables[name] = hash
+	}
+	for name, hash := range actionsSecret.Status.Variables {
+		if _, ok := variables[name]; ok {
+			continue
+		}
+		if err := target.deleteVariable(name); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting variable %s: %w", name, err))
+			if status.Variables == nil {
+				status.Variables = map[string]string{}
+			}
+			status.Variables[name] = hash
+		}
+	}
+
+	// save whatever made it to Github, even if some of it failed, so that a
+	// retry only has to redo the failed parts.
+	if !reflect.DeepEqual(status, actionsSecret.Status) {
+		actionsSecret.Status = status
+		if _, err := cl.GithubV1().ActionsSecrets(actionsSecret.Namespace).Update(actionsSecret); err != nil {
+			errs = append(errs, fmt.Errorf("error saving update to ActionsSecret resource: %w", err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// actionsSecretValues returns the secrets and variables 'actionsSecret'
+// publishes, keyed by their name on Github.
+func actionsSecretValues(actionsSecret *v1.ActionsSecret) (map[string][]byte, map[string]string, error) {
+	spec := actionsSecret.Spec
+	secrets := map[string][]byte{}
+	variables := map[string]string{}
+
+	if spec.SecretName != "" {
+		secret, err := kubeInformersFor(actionsSecret.Namespace).Core().V1().Secrets().Lister().Secrets(actionsSecret.Namespace).Get(spec.SecretName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting secret '%s/%s': %w", actionsSecret.Namespace, spec.SecretName, err)
+		}
+		keys := spec.SecretKeys
+		if len(keys) == 0 {
+			for key := range secret.Data {
+				keys = append(keys, v1.ActionsSecretKey{Key: key})
+			}
+		}
+		for _, key := range keys {
+			value, ok := secret.Data[key.Key]
+			if !ok {
+				return nil, nil, fmt.Errorf("secret '%s/%s' has no key %q", actionsSecret.Namespace, spec.SecretName, key.Key)
+			}
+			secrets[actionsName(key)] = value
+		}
+	}
+
+	if spec.ConfigMapName != "" {
+		configMap, err := kubeInformersFor(actionsSecret.Namespace).Core().V1().ConfigMaps().Lister().ConfigMaps(actionsSecret.Namespace).Get(spec.ConfigMapName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting configmap '%s/%s': %w", actionsSecret.Namespace, spec.ConfigMapName, err)
+		}
+		keys := spec.ConfigMapKeys
+		if len(keys) == 0 {
+			for key := range configMap.Data {
+				keys = append(keys, v1.ActionsSecretKey{Key: key})
+			}
+		}
+		for _, key := range keys {
+			value, ok := configMap.Data[key.Key]
+			if !ok {
+				return nil, nil, fmt.Errorf("configmap '%s/%s' has no key %q", actionsSecret.Namespace, spec.ConfigMapName, key.Key)
+			}
+			variables[actionsName(key)] = value
+		}
+	}
+
+	return secrets, variables, nil
+}
+
+// actionsName returns the name 'key' is published under.
+func actionsName(key v1.ActionsSecretKey) string {
+	if key.Name != "" {
+		return key.Name
+	}
+	return strings.ToUpper(actionsNameReplacer.Replace(key.Key))
+}
+
+// actionsValueHash hashes a published value for the status. The object UID
+// is mixed in so that the status cannot be used to look up common values.
+func actionsValueHash(actionsSecret *v1.ActionsSecret, name string, value []byte) string {
+	h := sha256.New()
+	h.Write([]byte(actionsSecret.UID))
+	h.Write([]byte(name))
+	h.Write(value)
+	return "sha256:" + hex.EncodeToString(h.Sum(nil))
+}
+
+// actionsTarget publishes secrets and variables at one scope.
+type actionsTarget interface {
+	putSecret(name string, value []byte) error
+	deleteSecret(name string) error
+	putVariable(name, value string) error
+	deleteVariable(name string) error
+}
+
+func newActionsTarget(spec v1.ActionsSecretSpec) (actionsTarget, error) {
+	switch spec.Scope {
+	case "", scopeRepository:
+		if spec.Repository == "" {
+			return nil, fmt.Errorf("repository is required for the %s scope", scopeRepository)
+		}
+		return &repositoryTarget{owner: spec.Owner, repo: spec.Repository}, nil
+	case scopeEnvironment:
+		if spec.Repository == "" || spec.Environment == "" {
+			return nil, fmt.Errorf("repository and environment are required for the %s scope", scopeEnvironment)
+		}
+		return &environmentTarget{owner: spec.Owner, repo: spec.Repository, env: spec.Environment}, nil
+	case scopeOrganization:
+		visibility := spec.Visibility
+		if visibility == "" {
+			visibility = "private"
+		}
+		return &organizationTarget{org: spec.Owner, visibility: visibility}, nil
+	}
+	return nil, fmt.Errorf("unknown scope %q", spec.Scope)
+}
+
+type repositoryTarget struct {
+	owner, repo string
+	key         *github.PublicKey
+}
+
+func (t *repositoryTarget) putSecret(name string, value []byte) error {
+	if t.key == nil {
+		key, _, err := githubClient.Actions.GetRepoPublicKey(ctx, t.owner, t.repo)
+		if err != nil {
+			return err
+		}
+		t.key = key
+	}
+	secret, err := sealSecret(t.key, name, value)
+	if err != nil {
+		return err
+	}
+	_, err = githubClient.Actions.CreateOrUpdateRepoSecret(ctx, t.owner, t.repo, secret)
+	return err
+}
+
+func (t *repositoryTarget) deleteSecret(name string) error {
+	return ignoreNotFound(githubClient.Actions.DeleteRepoSecret(ctx, t.owner, t.repo, name))
+}
+
+func (t *repositoryTarget) putVariable(name, value string) error {
+	variable := &github.ActionsVariable{Name: name, Value: value}
+	return upsertVariable(
+		func() (*github.Response, error) {
+			return githubClient.Actions.UpdateRepoVariable(ctx, t.owner, t.repo, variable)
+		},
+		func() (*github.Response, error) {
+			return githubClient.Actions.CreateRepoVariable(ctx, t.owner, t.repo, variable)
+		},
+	)
+}
+
+func (t *repositoryTarget) deleteVariable(name string) error {
+	return ignoreNotFound(githubClient.Actions.DeleteRepoVariable(ctx, t.owner, t.repo, name))
+}
+
+type environmentTarget struct {
+	owner, repo, env string
+	repoID           int
+	key              *github.PublicKey
+}
+
+// lookupRepoID resolves the repository ID environment secrets are addressed
+// by, rather than by owner and name.
+func (t *environmentTarget) lookupRepoID() error {
+	if t.repoID != 0 {
+		return nil
+	}
+	repo, _, err := githubClient.Repositories.Get(ctx, t.owner, t.repo)
+	if err != nil {
+		return err
+	}
+	t.repoID = int(repo.GetID())
+	return nil
+}
+
+func (t *environmentTarget) putSecret(name string, value []byte) error {
+	if err := t.lookupRepoID(); err != nil {
+		return err
+	}
+	if t.key == nil {
+		key, _, err := githubClient.Actions.GetEnvPublicKey(ctx, t.repoID, t.env)
+		if err != nil {
+			return err
+		}
+		t.key = key
+	}
+	secret, err := sealSecret(t.key, name, value)
+	if err != nil {
+		return err
+	}
+	_, err = githubClient.Actions.CreateOrUpdateEnvSecret(ctx, t.repoID, t.env, secret)
+	return err
+}
+
+func (t *environmentTarget) deleteSecret(name string) error {
+	if err := t.lookupRepoID(); err != nil {
+		return err
+	}
+	return ignoreNotFound(githubClient.Actions.DeleteEnvSecret(ctx, t.repoID, t.env, name))
+}
+
+func (t *environmentTarget) putVariable(name, value string) error {
+	variable := &github.ActionsVariable{Name: name, Value: value}
+	return upsertVariable(
+		func() (*github.Response, error) {
+			return githubClient.Actions.UpdateEnvVariable(ctx, t.owner, t.repo, t.env, variable)
+		},
+		func() (*github.Response, error) {
+			return githubClient.Actions.CreateEnvVariable(ctx, t.owner, t.repo, t.env, variable)
+		},
+	)
+}
+
+func (t *environmentTarget) deleteVariable(name string) error {
+	return ignoreNotFound(githubClient.Actions.DeleteEnvVariable(ctx, t.owner, t.repo, t.env, name))
+}
+
+type organizationTarget struct {
+	org, visibility string
+	key             *github.PublicKey
+}
+
+func (t *organizationTarget) putSecret(name string, value []byte) error {
+	if t.key == nil {
+		key, _, err := githubClient.Actions.GetOrgPublicKey(ctx, t.org)
+		if err != nil {
+			return err
+		}
+		t.key = key
+	}
+	secret, err := sealSecret(t.key, name, value)
+	if err != nil {
+		return err
+	}
+	secret.Visibility = t.visibility
+	_, err = githubClient.Actions.CreateOrUpdateOrgSecret(ctx, t.org, secret)
+	return err
+}
+
+func (t *organizationTarget) deleteSecret(name string) error {
+	return ignoreNotFound(githubClient.Actions.DeleteOrgSecret(ctx, t.org, name))
+}
+
+func (t *organizationTarget) putVariable(name, value string) error {
+	variable := &github.ActionsVariable{Name: name, Value: value, Visibility: &t.visibility}
+	return upsertVariable(
+		func() (*github.Response, error) { return githubClient.Actions.UpdateOrgVariable(ctx, t.org, variable) },
+		func() (*github.Response, error) { return githubClient.Actions.CreateOrgVariable(ctx, t.org, variable) },
+	)
+}
+
+func (t *organizationTarget) deleteVariable(name string) error {
+	return ignoreNotFound(githubClient.Actions.DeleteOrgVariable(ctx, t.org, name))
+}
+
+// sealSecret encrypts 'value' for Github with a NaCl sealed box, using the
+// public key of the repository, environment or organization it is stored in.
+func sealSecret(key *github.PublicKey, name string, value []byte) (*github.EncryptedSecret, error) {
+	raw, err := base64.StdEncoding.DecodeString(key.GetKey())
+	if err != nil {
+		return nil, fmt.Errorf("error decoding public key %s: %w", key.GetKeyID(), err)
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("public key %s is %d bytes long, expected 32", key.GetKeyID(), len(raw))
+	}
+	var publicKey [32]byte
+	copy(publicKey[:], raw)
+
+	sealed, err := box.SealAnonymous(nil, value, &publicKey, rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &github.EncryptedSecret{
+		Name:           name,
+		KeyID:          key.GetKeyID(),
+		EncryptedValue: base64.StdEncoding.EncodeToString(sealed),
+	}, nil
+}
+
+// upsertVariable updates a variable, creating it if it does not exist yet.
+func upsertVariable(update, create func() (*github.Response, error)) error {
+	resp, err := update()
+	if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+		_, err = create()
+	}
+	return err
+}
+
+// ignoreNotFound drops the error of a delete call when the thing to delete
+// was already gone.
+func ignoreNotFound(resp *github.Response, err error) error {
+	if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
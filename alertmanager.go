@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+)
+
+const (
+	// alertGroupLabel is set on the Comments created for alert groups, to
+	// the name of the Comment of the group.
+	alertGroupLabel = "github.k8s.io/alert-group"
+
+	// alerts are routed to the issue named by these labels, if they are
+	// common to the group, e.g. github_repository="nikhita/website" and
+	// github_issue="12".
+	alertRepositoryLabel = "github_repository"
+	alertIssueLabel      = "github_issue"
+)
+
+// alertmanagerReceiver turns the notifications of Alertmanager into
+// Comments.
+type alertmanagerReceiver struct {
+	// namespace is where the Comments are created.
+	namespace string
+	// issue is where alerts are posted unless their labels say otherwise.
+	issue v1.IssueReference
+	// token is the bearer token Alertmanager authenticates with.
+	token []byte
+	// repositories are the 'owner/repo' alerts may be routed to by their
+	// labels, in addition to the repository of issue.
+	repositories map[string]bool
+}
+
+// newAlertmanagerReceiver returns the receiver posting on the issue
+// 'owner/repo#number' by default, and on the issues of the comma separated
+// 'repositories' if the labels of the alerts say so. Notifications must
+// carry 'token' as bearer token.
+func newAlertmanagerReceiver(issue, namespace, token, repositories string) (*alertmanagerReceiver, error) {
+	if token == "" {
+		return nil, fmt.Errorf("a bearer token is required")
+	}
+	reference, err := parseIssueReference(issue)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &alertmanagerReceiver{
+		namespace:    namespace,
+		issue:        reference,
+		token:        []byte(token),
+		repositories: map[string]bool{reference.Owner + "/" + reference.Repository: true},
+	}
+	for _, repository := range strings.Split(repositories, ",") {
+		repository = strings.TrimSpace(repository)
+		if repository == "" {
+			continue
+		}
+		parts := strings.Split(repository, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid repository %q, expected owner/repo", repository)
+		}
+		r.repositories[repository] = true
+	}
+	return r, nil
+}
+
+// alertmanagerPayload is the body of the Alertmanager webhook, see
+// https://prometheus.io/docs/alerting/configuration/#webhook_config.
+type alertmanagerPayload struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []alert           `json:"alerts"`
+}
+
+type alert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+}
+
+// alertGroup is what the comment of an alert group is rendered with.
+type alertGroup struct {
+	Labels      string
+	Status      string
+	Alerts      []alert
+	ExternalURL string
+}
+
+var alertTemplate = template.Must(template.New("alerts").Parse(
+	"{{if eq .Status \"firing\"}}### :fire: {{len .Alerts}} firing: {{.Labels}}{{else}}### :white_check_mark: Resolved: {{.Labels}}{{end}}\n\n" +
+		"{{range .Alerts}}- **{{index .Labels \"alertname\"}}**" +
+		"{{with index .Annotations \"summary\"}} {{.}}{{end}}" +
+		" (since {{.StartsAt.Format \"2006-01-02 15:04:05 MST\"}}" +
+		"{{if eq .Status \"resolved\"}}, resolved {{.EndsAt.Format \"2006-01-02 15:04:05 MST\"}}{{end}})" +
+		"{{with .GeneratorURL}} [source]({{.}}){{end}}\n{{end}}" +
+		"{{with .ExternalURL}}\nSent by [Alertmanager]({{.}}).\n{{end}}"))
+
+// ServeHTTP handles one notification of Alertmanager. Errors are answered
+// with a server error, so that Alertmanager retries the notification.
+// Notifications that are not authenticated or that can not be routed are
+// rejected with a client error, which Alertmanager does not retry.
+func (r *alertmanagerReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), r.token) != 1 {
+		http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+		return
+	}
+
+	var payload alertmanagerPayload
+	if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+		http.Error(w, fmt.Sprintf("error decoding notification: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	issue, err := r.route(payload.CommonLabels)
+	if err != nil {
+		klog.InfoS("Rejecting Alertmanager notification", "groupKey", payload.GroupKey, "reason", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := r.receive(issue, payload); err != nil {
+		klog.ErrorS(err, "Error handling Alertmanager notification", "groupKey", payload.GroupKey)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// receive creates or updates the Comment of the alert group while it fires.
+// Once it is resolved, a resolved notice is posted as a Comment of its own,
+// and the Comment of the group is deleted so that the next time the group
+// fires gets a new comment. The comments on Github are kept. The comments
+// are posted on 'issue'.
+func (r *alertmanagerReceiver) receive(issue v1.IssueReference, payload alertmanagerPayload) error {
+	group := alertGroup{
+		Labels:      formatLabels(payload.GroupLabels),
+		Status:      payload.Status,
+		ExternalURL: payload.ExternalURL,
+	}
+	for _, a := range payload.Alerts {
+		if a.Status == payload.Status {
+			group.Alerts = append(group.Alerts, a)
+		}
+	}
+	var body bytes.Buffer
+	if err := alertTemplate.Execute(&body, group); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("alert-%x", sha256.Sum256([]byte(payload.GroupKey)))[:22]
+	comments := cl.GithubV1().Comments(r.namespace)
+	existing, err := comments.Get(name, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	found := err == nil
+
+	spec := v1.CommentSpec{
+		Message:    body.String(),
+		Owner:      issue.Owner,
+		Repository: issue.Repository,
+		Number:     issue.Number,
+	}
+
+	if payload.Status == "resolved" {
+		if !found {
+			// nothing was posted for the group, e.g. because it fired before
+			// the receiver was set up.
+			return nil
+		}
+		// Alertmanager retries the notification if the group Comment
+		// could not be deleted below. The name of the resolved notice
+		// stays the same then, so that it is only posted once.
+		resolved := &v1.Comment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   resolvedCommentName(name, payload.GroupKey, group.Alerts),
+				Labels: commentLabels(map[string]string{alertGroupLabel: name}),
+			},
+			Spec: spec,
+		}
+		if _, err := comments.Create(resolved); err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+		if err := comments.Delete(name, &metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		klog.InfoS("Alert group resolved", "groupKey", payload.GroupKey, "comment", klog.KRef(r.namespace, resolved.Name))
+		return nil
+	}
+
+	if !found {
+		comment := &v1.Comment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: commentLabels(map[string]string{alertGroupLabel: name}),
+			},
+			Spec: spec,
+		}
+		if _, err := comments.Create(comment); err != nil {
+			return err
+		}
+		klog.InfoS("Alert group firing", "groupKey", payload.GroupKey, "comment", klog.KRef(r.namespace, name))
+		return nil
+	}
+
+	if existing.Spec == spec {
+		return nil
+	}
+	existing.Spec = spec
+	if _, err := comments.Update(existing); err != nil {
+		return err
+	}
+	klog.InfoS("Alert group changed", "groupKey", payload.GroupKey, "comment", klog.KRef(r.namespace, name))
+	return nil
+}
+
+// route returns the issue alerts with 'labels' are posted on. It may only
+// be in one of the allowed repositories. Alerts routed to another repository
+// than the one of the default issue must name the issue too, the number of
+// the default issue means nothing there.
+func (r *alertmanagerReceiver) route(labels map[string]string) (v1.IssueReference, error) {
+	issue := r.issue
+	number, hasNumber := labels[alertIssueLabel]
+	if repository, ok := labels[alertRepositoryLabel]; ok {
+		parts := strings.Split(repository, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return issue, fmt.Errorf("invalid %s label %q, expected owner/repo", alertRepositoryLabel, repository)
+		}
+		if !r.repositories[repository] {
+			return issue, fmt.Errorf("alerts may not be posted in repository %q", repository)
+		}
+		if !hasNumber && (parts[0] != issue.Owner || parts[1] != issue.Repository) {
+			return issue, fmt.Errorf("the %s label is required along with %s label %q", alertIssueLabel, alertRepositoryLabel, repository)
+		}
+		issue.Owner, issue.Repository = parts[0], parts[1]
+	}
+	if hasNumber {
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return issue, fmt.Errorf("invalid %s label %q: %w", alertIssueLabel, number, err)
+		}
+		if n <= 0 {
+			return issue, fmt.Errorf("invalid %s label %q, expected a positive number", alertIssueLabel, number)
+		}
+		issue.Number = n
+	}
+	return issue, nil
+}
+
+// resolvedCommentName returns the name of the Comment of the resolved notice
+// of the group with 'groupKey', whose Comment is 'name', for 'alerts'. It is
+// derived from when the alerts ended, so that the same resolution always
+// gets the same name, and the next one a new one.
+func resolvedCommentName(name, groupKey string, alerts []alert) string {
+	var ends []string
+	for _, a := range alerts {
+		ends = append(ends, a.EndsAt.UTC().Format(time.RFC3339Nano))
+	}
+	sort.Strings(ends)
+	hash := sha256.Sum256([]byte(groupKey + "\n" + strings.Join(ends, "\n")))
+	return fmt.Sprintf("%s-resolved-%x", name, hash[:5])
+}
+
+// formatLabels returns 'labels' as sorted 'name=value' pairs.
+func formatLabels(labels map[string]string) string {
+	var pairs []string
+	for name, value := range labels {
+		pairs = append(pairs, name+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
@@ -0,0 +1,426 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// chatOps runs the slash commands posted in issue comments. It is nil when
+// they are disabled.
+var chatOps *chatOpsConfig
+
+// chatOpsSeenFor is how long the IDs of the comments whose command was queued
+// are remembered. The webhook and the importer may both see a comment, and
+// the importer only up to an interval later.
+const chatOpsSeenFor = time.Hour * 24
+
+// chatOpsQueue holds the IDs of the comments whose command waits to be run,
+// the commands are kept in chatOpsCommands.
+var chatOpsQueue = newQueue("chatops")
+
+// chatOpsCommands holds the commands waiting in chatOpsQueue, and the IDs of
+// the comments whose command was queued recently, so that every command runs
+// only once.
+var chatOpsCommands = struct {
+	sync.Mutex
+	pending map[string]chatOpsRequest
+	seen    *utilcache.LRUExpireCache
+}{pending: map[string]chatOpsRequest{}, seen: utilcache.NewLRUExpireCache(1000)}
+
+// chatOpsRequest is a comment holding a command, posted by Author on issue
+// Number of Owner/Repo.
+type chatOpsRequest struct {
+	Owner  string
+	Repo   string
+	Number int
+	Author string
+	Body   string
+}
+
+// restConfig is the configuration the clients were created from. ChatOps
+// commands run with a copy of it that impersonates the author.
+var restConfig *rest.Config
+
+// chatOpsConfig lists who may run commands, and as whom. Commands run
+// impersonating the identity the author is mapped to, so what they may do is
+// decided by the RBAC rules of that identity.
+type chatOpsConfig struct {
+	// DefaultNamespace is used by commands that do not pass -n.
+	DefaultNamespace string `json:"defaultNamespace"`
+
+	// Users maps Github logins to Kubernetes identities.
+	Users map[string]chatOpsIdentity `json:"users"`
+	// Teams maps Github teams, as 'org/team-slug', to Kubernetes
+	// identities. They are checked in order of their name when the author
+	// is not listed in Users.
+	Teams map[string]chatOpsIdentity `json:"teams"`
+}
+
+// chatOpsIdentity is the Kubernetes user commands are run as.
+type chatOpsIdentity struct {
+	Username string   `json:"username"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// chatCommand is a parsed slash command, like '/scale deployment/web 3 -n
+// production'.
+type chatCommand struct {
+	Text      string
+	Name      string
+	Kind      string
+	Target    string
+	Namespace string
+	Args      []string
+}
+
+// loadChatOpsConfig reads the ChatOps configuration from the YAML file at
+// 'path'.
+func loadChatOpsConfig(path string) (*chatOpsConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &chatOpsConfig{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	if config.DefaultNamespace == "" {
+		config.DefaultNamespace = "default"
+	}
+	for name, identity := range config.Users {
+		if identity.Username == "" {
+			return nil, fmt.Errorf("user %s is not mapped to a username", name)
+		}
+	}
+	for name, identity := range config.Teams {
+		if len(strings.Split(name, "/")) != 2 {
+			return nil, fmt.Errorf("invalid team %q, expected org/team-slug", name)
+		}
+		if identity.Username == "" {
+			return nil, fmt.Errorf("team %s is not mapped to a username", name)
+		}
+	}
+	return config, nil
+}
+
+// parseChatCommand parses the first line of 'body'. It returns nil if the
+// comment is not a command.
+func parseChatCommand(body string, defaultNamespace string) (*chatCommand, error) {
+	line := strings.TrimSpace(strings.SplitN(strings.TrimSpace(body), "\n", 2)[0])
+	if !strings.HasPrefix(line, "/") {
+		return nil, nil
+	}
+	fields := strings.Fields(line)
+	cmd := &chatCommand{Text: line, Name: strings.TrimPrefix(fields[0], "/"), Namespace: defaultNamespace}
+	switch cmd.Name {
+	case "scale", "restart", "rollback", "status":
+	default:
+		return nil, nil
+	}
+
+	var args []string
+	for i := 1; i < len(fields); i++ {
+		if fields[i] == "-n" || fields[i] == "--namespace" {
+			if i+1 == len(fields) {
+				return cmd, fmt.Errorf("%s needs a namespace", fields[i])
+			}
+			cmd.Namespace = fields[i+1]
+			i++
+			continue
+		}
+		args = append(args, fields[i])
+	}
+	if len(args) == 0 {
+		return cmd, fmt.Errorf("usage: /%s deployment/<name> [-n <namespace>]", cmd.Name)
+	}
+	parts := strings.SplitN(args[0], "/", 2)
+	if len(parts) != 2 || (parts[0] != "deployment" && parts[0] != "deploy") {
+		return cmd, fmt.Errorf("only deployments are supported, e.g. deployment/web")
+	}
+	cmd.Kind, cmd.Target, cmd.Args = "Deployment", parts[1], args[1:]
+	return cmd, nil
+}
+
+// enqueue queues the command in the comment 'id', 'body' posted by 'author'
+// on issue 'number' of 'owner/repo', unless the comment was queued before.
+// Comments that are not commands are ignored. Commands are run by the
+// workers of chatOpsQueue, so that the webhook is answered before they ran.
+func (c *chatOpsConfig) enqueue(id int64, owner, repo string, number int, author, body string) {
+	if cmd, _ := parseChatCommand(body, c.DefaultNamespace); cmd == nil {
+		return
+	}
+
+	key := strconv.FormatInt(id, 10)
+	chatOpsCommands.Lock()
+	if _, seen := chatOpsCommands.seen.Get(key); seen {
+		chatOpsCommands.Unlock()
+		klog.V(4).InfoS("Skipping ChatOps command that was queued before", "commentID", id)
+		return
+	}
+	chatOpsCommands.seen.Add(key, true, chatOpsSeenFor)
+	chatOpsCommands.pending[key] = chatOpsRequest{Owner: owner, Repo: repo, Number: number, Author: author, Body: body}
+	chatOpsCommands.Unlock()
+	chatOpsQueue.Add(key)
+}
+
+// requeueChatOpsCommands enqueues the commands waiting to be run, e.g. the
+// ones skipped while the controller was disabled.
+func requeueChatOpsCommands() {
+	chatOpsCommands.Lock()
+	defer chatOpsCommands.Unlock()
+	for key := range chatOpsCommands.pending {
+		chatOpsQueue.Add(key)
+	}
+}
+
+// processChatOpsCommand runs the command queued for the comment with the ID
+// 'name'. ChatOps commands have no namespace. Running a command again would
+// repeat what it did, so failures are only logged.
+func processChatOpsCommand(namespace, name string) error {
+	chatOpsCommands.Lock()
+	request, ok := chatOpsCommands.pending[name]
+	delete(chatOpsCommands.pending, name)
+	chatOpsCommands.Unlock()
+	if !ok || chatOps == nil {
+		return nil
+	}
+
+	if err := chatOps.handle(request.Owner, request.Repo, request.Number, request.Author, request.Body); err != nil {
+		klog.ErrorS(err, "Error handling ChatOps command", "commentID", name)
+	}
+	return nil
+}
+
+// handle runs the command in the comment 'body' posted by 'author' on issue
+// 'number' of 'owner/repo', replies with the result and records it as an
+// Event on the target. Comments that are not commands are ignored.
+func (c *chatOpsConfig) handle(owner, repo string, number int, author, body string) error {
+	cmd, err := parseChatCommand(body, c.DefaultNamespace)
+	if cmd == nil {
+		return nil
+	}
+
+	// commands that can not be parsed, or whose author may not run
+	// commands, are rejected before they run.
+	var identity chatOpsIdentity
+	if err == nil {
+		identity, err = c.identity(author)
+	}
+	rejected := err != nil
+
+	var result string
+	if !rejected {
+		result, err = runChatCommand(identity, cmd)
+	}
+
+	reply := fmt.Sprintf(":white_check_mark: `%s`\n\n```\n%s\n```\n", cmd.Text, result)
+	if err != nil {
+		reply = fmt.Sprintf(":x: `%s` failed: %s\n", cmd.Text, err.Error())
+	}
+	klog.InfoS("Ran ChatOps command", "command", cmd.Text, "author", author, "issue", fmt.Sprintf("%s/%s#%d", owner, repo, number), "reply", strings.TrimSpace(reply))
+
+	if rejected {
+		auditRejectedChatCommand(c.DefaultNamespace, cmd, author, fmt.Sprintf("%s/%s#%d", owner, repo, number), err)
+	} else {
+		auditChatCommand(cmd, author, fmt.Sprintf("%s/%s#%d", owner, repo, number), err)
+	}
+	if _, sendErr := sendComment(ctx, githubClient, owner, repo, number, reply); sendErr != nil {
+		return fmt.Errorf("error replying to command %q: %w", cmd.Text, sendErr)
+	}
+	return nil
+}
+
+// identity returns the Kubernetes identity of the Github user 'login'.
+func (c *chatOpsConfig) identity(login string) (chatOpsIdentity, error) {
+	if identity, ok := c.Users[login]; ok {
+		return identity, nil
+	}
+
+	var teams []string
+	for team := range c.Teams {
+		teams = append(teams, team)
+	}
+	sort.Strings(teams)
+	for _, team := range teams {
+		parts := strings.Split(team, "/")
+		membership, _, err := githubClient.Teams.GetTeamMembershipBySlug(ctx, parts[0], parts[1], login)
+		if err != nil {
+			// not a member, or we may not see the team.
+			continue
+		}
+		if membership.GetState() == "active" {
+			return c.Teams[team], nil
+		}
+	}
+	return chatOpsIdentity{}, fmt.Errorf("%s is not allowed to run commands", login)
+}
+
+// runChatCommand runs 'cmd' as 'identity' and returns its output.
+func runChatCommand(identity chatOpsIdentity, cmd *chatCommand) (string, error) {
+	config := rest.CopyConfig(restConfig)
+	config.Impersonate = rest.ImpersonationConfig{UserName: identity.Username, Groups: identity.Groups}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return "", err
+	}
+	deployments := client.AppsV1().Deployments(cmd.Namespace)
+
+	switch cmd.Name {
+	case "status":
+		deployment, err := deployments.Get(cmd.Target, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return deploymentStatus(deployment), nil
+
+	case "scale":
+		if len(cmd.Args) != 1 {
+			return "", fmt.Errorf("usage: /scale deployment/<name> <replicas> [-n <namespace>]")
+		}
+		replicas, err := strconv.Atoi(cmd.Args[0])
+		if err != nil || replicas < 0 {
+			return "", fmt.Errorf("invalid number of replicas %q", cmd.Args[0])
+		}
+		patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+		deployment, err := deployments.Patch(cmd.Target, types.StrategicMergePatchType, []byte(patch))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("scaled to %d replicas\n%s", replicas, deploymentStatus(deployment)), nil
+
+	case "restart":
+		// the same as 'kubectl rollout restart'.
+		patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":%q}}}}}`, time.Now().Format(time.RFC3339))
+		deployment, err := deployments.Patch(cmd.Target, types.StrategicMergePatchType, []byte(patch))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("restarted\n%s", deploymentStatus(deployment)), nil
+
+	case "rollback":
+		return rollbackDeployment(client, cmd.Namespace, cmd.Target)
+	}
+	return "", fmt.Errorf("unknown command %q", cmd.Name)
+}
+
+// rollbackDeployment rolls the deployment 'name' back to the revision before
+// the current one, like 'kubectl rollout undo'.
+func rollbackDeployment(client kubernetes.Interface, namespace, name string) (string, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return "", err
+	}
+	replicaSets, err := client.AppsV1().ReplicaSets(namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return "", err
+	}
+
+	current, _ := strconv.Atoi(deployment.Annotations["deployment.kubernetes.io/revision"])
+	var previous *appsv1.ReplicaSet
+	previousRevision := 0
+	for i := range replicaSets.Items {
+		rs := &replicaSets.Items[i]
+		if ref := metav1.GetControllerOf(rs); ref == nil || ref.UID != deployment.UID {
+			continue
+		}
+		revision, _ := strconv.Atoi(rs.Annotations["deployment.kubernetes.io/revision"])
+		if revision < current && revision > previousRevision {
+			previous, previousRevision = rs, revision
+		}
+	}
+	if previous == nil {
+		return "", fmt.Errorf("deployment %s/%s has no previous revision", namespace, name)
+	}
+
+	template := previous.Spec.Template.DeepCopy()
+	delete(template.Labels, "pod-template-hash")
+	deployment.Spec.Template = *template
+	deployment, err = client.AppsV1().Deployments(namespace).Update(deployment)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("rolled back to revision %d\n%s", previousRevision, deploymentStatus(deployment)), nil
+}
+
+func deploymentStatus(deployment *appsv1.Deployment) string {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return fmt.Sprintf("deployment %s/%s: %d desired, %d updated, %d ready, %d available",
+		deployment.Namespace, deployment.Name, replicas, deployment.Status.UpdatedReplicas,
+		deployment.Status.ReadyReplicas, deployment.Status.AvailableReplicas)
+}
+
+// auditChatCommand records 'cmd' as an Event on its target, whether it
+// succeeded or not.
+func auditChatCommand(cmd *chatCommand, author, issue string, cmdErr error) {
+	eventType, reason, message := corev1.EventTypeNormal, "ChatOpsCommand", fmt.Sprintf("%s ran %q on %s", author, cmd.Text, issue)
+	if cmdErr != nil {
+		eventType, reason = corev1.EventTypeWarning, "ChatOpsCommandFailed"
+		message = fmt.Sprintf("%s: %s", message, cmdErr.Error())
+	}
+	recordChatOpsEvent(cmd.Text, corev1.ObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       cmd.Kind,
+		Namespace:  cmd.Namespace,
+		Name:       cmd.Target,
+	}, eventType, reason, message)
+}
+
+// auditRejectedChatCommand records 'cmd', which was rejected for 'reason'
+// before it ran, as an Event on 'namespace'. Rejected commands may name no
+// target, or one in a namespace their author may not touch, so they are
+// recorded in the default namespace of ChatOps rather than the one they
+// name.
+func auditRejectedChatCommand(namespace string, cmd *chatCommand, author, issue string, reason error) {
+	message := fmt.Sprintf("%s ran %q on %s, rejected: %s", author, cmd.Text, issue, reason.Error())
+	recordChatOpsEvent(cmd.Text, corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Namespace",
+		Namespace:  namespace,
+		Name:       namespace,
+	}, corev1.EventTypeWarning, "ChatOpsCommandRejected", message)
+}
+
+// recordChatOpsEvent creates an Event about the command 'text' on
+// 'involved', in its namespace.
+func recordChatOpsEvent(text string, involved corev1.ObjectReference, eventType, reason, message string) {
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: strings.ToLower(involved.Name) + "-chatops-",
+			Namespace:    involved.Namespace,
+		},
+		InvolvedObject: involved,
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Source:         corev1.EventSource{Component: "kube-custom-controller"},
+	}
+	if _, err := kubeClient.CoreV1().Events(involved.Namespace).Create(event); err != nil {
+		klog.ErrorS(err, "Error recording ChatOps command", "command", text)
+	}
+}
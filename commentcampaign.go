@@ -0,0 +1,284 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/google/go-github/github"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+)
+
+const (
+	defaultCampaignConcurrency = 4
+
+	// campaignStatusInterval is the number of deliveries after which the
+	// status of a CommentCampaign is saved, so that a restart in the middle
+	// of a large campaign does not post its comments twice.
+	campaignStatusInterval = 20
+
+	// campaignRequeueInterval is how often a CommentCampaign with a query
+	// is synced again to pick up newly matching issues. Resyncs do not help
+	// here as they are filtered out when nothing changed.
+	campaignRequeueInterval = time.Minute * 10
+)
+
+var commentCampaignQueue = newQueue("commentcampaigns")
+
+// campaignIssue is what the message template of a CommentCampaign is
+// rendered with.
+type campaignIssue struct {
+	Owner      string
+	Repository string
+	Number     int
+	Title      string
+	URL        string
+}
+
+func (i campaignIssue) key() string {
+	return fmt.Sprintf("%s/%s#%d", i.Owner, i.Repository, i.Number)
+}
+
+// processCommentCampaign retrieves the latest version of the CommentCampaign
+// 'namespace/name' from the cache and syncs it.
+func processCommentCampaign(namespace, name string) error {
+	obj, err := informersFor(namespace).Github().V1().CommentCampaigns().Lister().CommentCampaigns(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		// deleted, the comments posted so far are kept.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting object '%s/%s' from api: %w", namespace, name, err)
+	}
+
+	return syncCommentCampaign(obj.DeepCopy())
+}
+
+// syncCommentCampaign posts the campaign message on every matching issue
+// that did not get it yet, at most spec.Concurrency at a time, and records
+// the delivery to each of them in status every campaignStatusInterval
+// deliveries. Issues that newly match the query are picked up whenever the
+// campaign is synced again.
+func syncCommentCampaign(campaign *v1.CommentCampaign) error {
+	spec := campaign.Spec
+	message, err := template.New("message").Parse(spec.Message)
+	if err != nil {
+		return fmt.Errorf("error parsing message of '%s/%s': %w", campaign.Namespace, campaign.Name, err)
+	}
+
+	issues, err := campaignIssues(spec)
+	if err != nil {
+		return fmt.Errorf("error resolving issues of '%s/%s': %w", campaign.Namespace, campaign.Name, err)
+	}
+
+	// start from what was recorded so far, so that issues that no longer
+	// match keep their delivery record.
+	targets := map[string]*v1.CommentCampaignTarget{}
+	var order []string
+	for i := range campaign.Status.Targets {
+		target := campaign.Status.Targets[i]
+		issue := campaignIssue{Owner: target.Owner, Repository: target.Repository, Number: target.Number}
+		targets[issue.key()] = &target
+		order = append(order, issue.key())
+	}
+
+	var pending []campaignIssue
+	for _, issue := range issues {
+		target, ok := targets[issue.key()]
+		if !ok {
+			target = &v1.CommentCampaignTarget{Owner: issue.Owner, Repository: issue.Repository, Number: issue.Number}
+			targets[issue.key()] = target
+			order = append(order, issue.key())
+		}
+		if !target.Delivered {
+			pending = append(pending, issue)
+		}
+	}
+
+	concurrency := spec.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultCampaignConcurrency
+	}
+
+	var (
+		lock      sync.Mutex
+		wg        sync.WaitGroup
+		sem       = make(chan struct{}, concurrency)
+		completed int
+	)
+	// saveStatus records the deliveries so far. Only this goroutine updates
+	// the campaign, the lock is only held to read the targets.
+	saveStatus := func() (v1.CommentCampaignStatus, error) {
+		lock.Lock()
+		status := campaignStatus(order, targets)
+		lock.Unlock()
+
+		if reflect.DeepEqual(status, campaign.Status) {
+			return status, nil
+		}
+		campaign.Status = status
+		updated, err := cl.GithubV1().CommentCampaigns(campaign.Namespace).Update(campaign)
+		if err != nil {
+			return status, fmt.Errorf("error saving update to CommentCampaign resource: %w", err)
+		}
+		*campaign = *updated
+		return status, nil
+	}
+
+	saved := 0
+	for _, issue := range pending {
+		lock.Lock()
+		due := completed-saved >= campaignStatusInterval
+		if due {
+			saved = completed
+		}
+		lock.Unlock()
+		if due {
+			if _, err := saveStatus(); err != nil {
+				klog.ErrorS(err, "Error saving progress of CommentCampaign", "commentCampaign", klog.KObj(campaign))
+			}
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(issue campaignIssue) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			comment, err := postCampaignComment(message, issue)
+
+			lock.Lock()
+			defer lock.Unlock()
+			completed++
+			target := targets[issue.key()]
+			if err != nil {
+				target.Error = err.Error()
+				return
+			}
+			target.Delivered = true
+			target.CommentID = comment.GetID()
+			target.URL = comment.GetHTMLURL()
+			target.Error = ""
+		}(issue)
+	}
+	wg.Wait()
+
+	status, err := saveStatus()
+	if err != nil {
+		return err
+	}
+	klog.InfoS("Delivered CommentCampaign", "commentCampaign", klog.KObj(campaign), "delivered", status.Delivered, "targets", len(status.Targets))
+
+	// failed deliveries are retried with backoff.
+	if status.Failed > 0 {
+		return fmt.Errorf("failed to deliver to %d issues", status.Failed)
+	}
+
+	if spec.Query != "" {
+		key, err := cache.MetaNamespaceKeyFunc(campaign)
+		if err != nil {
+			return err
+		}
+		commentCampaignQueue.AddAfter(key, campaignRequeueInterval)
+	}
+	return nil
+}
+
+// campaignStatus returns the status recording the delivery to 'targets', in
+// 'order'.
+func campaignStatus(order []string, targets map[string]*v1.CommentCampaignTarget) v1.CommentCampaignStatus {
+	status := v1.CommentCampaignStatus{}
+	for _, key := range order {
+		target := targets[key]
+		status.Targets = append(status.Targets, *target)
+		if target.Delivered {
+			status.Delivered++
+		} else if target.Error != "" {
+			status.Failed++
+		}
+	}
+	return status
+}
+
+// postCampaignComment renders 'message' for 'issue' and posts it.
+func postCampaignComment(message *template.Template, issue campaignIssue) (*github.IssueComment, error) {
+	if issue.Title == "" {
+		// issues listed explicitly still need their title and URL for the
+		// template.
+		ghIssue, _, err := githubClient.Issues.Get(ctx, issue.Owner, issue.Repository, issue.Number)
+		if err != nil {
+			return nil, err
+		}
+		issue.Title = ghIssue.GetTitle()
+		issue.URL = ghIssue.GetHTMLURL()
+	}
+
+	var body bytes.Buffer
+	if err := message.Execute(&body, issue); err != nil {
+		return nil, err
+	}
+	return sendComment(ctx, githubClient, issue.Owner, issue.Repository, issue.Number, body.String())
+}
+
+// campaignIssues returns the issues listed in 'spec' followed by all pages of
+// the issues matching its query, without duplicates.
+func campaignIssues(spec v1.CommentCampaignSpec) ([]campaignIssue, error) {
+	seen := map[string]bool{}
+	var issues []campaignIssue
+	add := func(issue campaignIssue) {
+		if !seen[issue.key()] {
+			seen[issue.key()] = true
+			issues = append(issues, issue)
+		}
+	}
+
+	for _, ref := range spec.Issues {
+		add(campaignIssue{Owner: ref.Owner, Repository: ref.Repository, Number: ref.Number})
+	}
+
+	if spec.Query == "" {
+		return issues, nil
+	}
+	opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		result, resp, err := githubClient.Search.Issues(ctx, spec.Query, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range result.Issues {
+			owner, repo, err := repositoryFromURL(issue.GetRepositoryURL())
+			if err != nil {
+				return nil, err
+			}
+			add(campaignIssue{
+				Owner:      owner,
+				Repository: repo,
+				Number:     issue.GetNumber(),
+				Title:      issue.GetTitle(),
+				URL:        issue.GetHTMLURL(),
+			})
+		}
+		if resp.NextPage == 0 {
+			return issues, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// repositoryFromURL extracts owner and name from a repository API URL like
+// https://api.github.com/repos/nikhita/kube-custom-controller.
+func repositoryFromURL(url string) (string, string, error) {
+	parts := strings.Split(strings.TrimSuffix(url, "/"), "/")
+	if len(parts) < 3 || parts[len(parts)-3] != "repos" {
+		return "", "", fmt.Errorf("unexpected repository URL %q", url)
+	}
+	return parts[len(parts)-2], parts[len(parts)-1], nil
+}
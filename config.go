@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	commentcontroller "github.com/nikhita/kube-custom-controller/pkg/controller"
+)
+
+const (
+	// the version of the configuration file format.
+	configurationAPIVersion = "github.k8s.io/v1alpha1"
+	configurationKind       = "ControllerConfiguration"
+
+	// configurationReloadInterval is how often the configuration file is
+	// checked for changes. Polling also catches the atomic symlink swaps
+	// of mounted ConfigMaps.
+	configurationReloadInterval = time.Second * 10
+)
+
+// controllerNames are the names of the controllers, which features toggle.
+var controllerNames = []string{
+	commentcontroller.Name,
+	"pullrequests",
+	"discussions",
+	"actionssecrets",
+	"workflowtriggers",
+	"repositoryfiles",
+	"commentcampaigns",
+	"notificationrules",
+	"notifications",
+	"chatops",
+	"events",
+	"crashloops",
+	"previews",
+}
+
+// controllerConfiguration is the configuration file of the controller.
+// ResyncPeriod, Workers, Namespaces and the selectors are read when the
+// controller starts, changing them needs a restart. The other settings are
+// reloaded when the file changes.
+type controllerConfiguration struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+
+	// ResyncPeriod is how often every object is synced again, in case a
+	// change was missed.
+	ResyncPeriod metav1.Duration `json:"resyncPeriod,omitempty"`
+	// Workers is the number of workers processing each queue concurrently.
+	Workers int `json:"workers,omitempty"`
+	// Namespaces are the namespaces to watch, every namespace if empty.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// LabelSelector and FieldSelector select the objects of our resources
+	// that are watched.
+	LabelSelector string `json:"labelSelector,omitempty"`
+	FieldSelector string `json:"fieldSelector,omitempty"`
+
+	// Retry is how long objects that failed to sync wait before they are
+	// retried.
+	Retry retryConfiguration `json:"retry,omitempty"`
+	// GithubRateLimit limits the requests made to the Github API.
+	GithubRateLimit rateLimitConfiguration `json:"githubRateLimit,omitempty"`
+	// DefaultIssue is where Comments that name no issue are posted.
+	DefaultIssue *v1.IssueReference `json:"defaultIssue,omitempty"`
+	// Features enables or disables controllers by name, like
+	// 'pullrequests'. Controllers not listed are enabled.
+	Features map[string]bool `json:"features,omitempty"`
+}
+
+// retryConfiguration is the exponential backoff of failed objects, which
+// doubles from BaseDelay up to MaxDelay.
+type retryConfiguration struct {
+	BaseDelay metav1.Duration `json:"baseDelay,omitempty"`
+	MaxDelay  metav1.Duration `json:"maxDelay,omitempty"`
+}
+
+// rateLimitConfiguration allows QPS requests per second on average, and
+// bursts of up to Burst requests. A QPS of 0 does not limit requests.
+type rateLimitConfiguration struct {
+	QPS   float64 `json:"qps,omitempty"`
+	Burst int     `json:"burst,omitempty"`
+}
+
+// configuration is the configuration in effect.
+var configuration = struct {
+	sync.RWMutex
+	c *controllerConfiguration
+}{c: defaultConfiguration()}
+
+// githubLimiter limits the requests made to the Github API, see
+// githubTransport.
+var githubLimiter = rate.NewLimiter(rate.Inf, 1)
+
+// requeueOnEnable adds every object of a controller into its queue, by the
+// name of the controller. Disabled controllers skip the objects they are
+// handed, so these are requeued when the controller is enabled again. It is
+// filled before the configuration is watched.
+var requeueOnEnable = map[string]func(){}
+
+// currentConfiguration returns the configuration in effect. It must not be
+// modified.
+func currentConfiguration() *controllerConfiguration {
+	configuration.RLock()
+	defer configuration.RUnlock()
+	return configuration.c
+}
+
+func defaultConfiguration() *controllerConfiguration {
+	return &controllerConfiguration{
+		APIVersion:   configurationAPIVersion,
+		Kind:         configurationKind,
+		ResyncPeriod: metav1.Duration{Duration: time.Second * 30},
+		Workers:      1,
+		Retry: retryConfiguration{
+			BaseDelay: metav1.Duration{Duration: time.Second * 5},
+			MaxDelay:  metav1.Duration{Duration: time.Minute},
+		},
+	}
+}
+
+// loadConfiguration reads the configuration file at 'path'. Unset settings
+// keep their defaults. Unknown fields are rejected, so that typos do not go
+// unnoticed.
+func loadConfiguration(path string) (*controllerConfiguration, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	c := defaultConfiguration()
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(c); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	if err := c.validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration in %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// validate returns the first setting of 'c' that is invalid, if any.
+func (c *controllerConfiguration) validate() error {
+	if c.APIVersion != configurationAPIVersion || c.Kind != configurationKind {
+		return fmt.Errorf("expected apiVersion %s and kind %s, got %q and %q", configurationAPIVersion, configurationKind, c.APIVersion, c.Kind)
+	}
+	if c.ResyncPeriod.Duration <= 0 {
+		return fmt.Errorf("resyncPeriod must be positive, got %s", c.ResyncPeriod.Duration)
+	}
+	if c.Workers < 1 {
+		return fmt.Errorf("workers must be at least 1, got %d", c.Workers)
+	}
+	for _, namespace := range c.Namespaces {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return fmt.Errorf("invalid namespace %q: %s", namespace, strings.Join(errs, ", "))
+		}
+	}
+	if _, err := labels.Parse(c.LabelSelector); err != nil {
+		return fmt.Errorf("invalid labelSelector %q: %w", c.LabelSelector, err)
+	}
+	if _, err := fields.ParseSelector(c.FieldSelector); err != nil {
+		return fmt.Errorf("invalid fieldSelector %q: %w", c.FieldSelector, err)
+	}
+	if c.Retry.BaseDelay.Duration <= 0 || c.Retry.MaxDelay.Duration < c.Retry.BaseDelay.Duration {
+		return fmt.Errorf("retry.baseDelay must be positive and at most retry.maxDelay, got %s and %s", c.Retry.BaseDelay.Duration, c.Retry.MaxDelay.Duration)
+	}
+	if c.GithubRateLimit.QPS < 0 {
+		return fmt.Errorf("githubRateLimit.qps must not be negative, got %v", c.GithubRateLimit.QPS)
+	}
+	if c.GithubRateLimit.QPS > 0 && c.GithubRateLimit.Burst < 1 {
+		return fmt.Errorf("githubRateLimit.burst must be at least 1 when githubRateLimit.qps is set, got %d", c.GithubRateLimit.Burst)
+	}
+	if issue := c.DefaultIssue; issue != nil && (issue.Owner == "" || issue.Repository == "" || issue.Number <= 0) {
+		return fmt.Errorf("defaultIssue must have an owner, repository and number")
+	}
+	for name := range c.Features {
+		known := false
+		for _, controllerName := range controllerNames {
+			known = known || name == controllerName
+		}
+		if !known {
+			return fmt.Errorf("unknown feature %q, expected one of %v", name, controllerNames)
+		}
+	}
+	return nil
+}
+
+// enabled reports whether the controller 'name' is enabled.
+func (c *controllerConfiguration) enabled(name string) bool {
+	enabled, ok := c.Features[name]
+	return !ok || enabled
+}
+
+// applyConfiguration puts 'c' into effect.
+func applyConfiguration(c *controllerConfiguration) {
+	configuration.Lock()
+	configuration.c = c
+	configuration.Unlock()
+
+	if c.DefaultIssue != nil {
+		commentcontroller.SetDefaultIssue(*c.DefaultIssue)
+	} else {
+		commentcontroller.ResetDefaultIssue()
+	}
+	if c.GithubRateLimit.QPS > 0 {
+		githubLimiter.SetBurst(c.GithubRateLimit.Burst)
+		githubLimiter.SetLimit(rate.Limit(c.GithubRateLimit.QPS))
+	} else {
+		githubLimiter.SetLimit(rate.Inf)
+	}
+}
+
+// watchConfiguration reloads the configuration file at 'path' whenever it
+// changed, until 'stopCh' is closed. Invalid configurations are logged and
+// ignored, the one in effect is kept.
+func watchConfiguration(path string, stopCh <-chan struct{}) {
+	// changes to the settings read at start are told from the file read
+	// before, the configuration in effect has the flags applied.
+	previous, _ := loadConfiguration(path)
+	wait.Until(func() {
+		c, err := loadConfiguration(path)
+		if err != nil {
+			klog.ErrorS(err, "Error reloading configuration, keeping the current one", "path", path)
+			return
+		}
+		if previous != nil && !reflect.DeepEqual(startSettings(c), startSettings(previous)) {
+			klog.InfoS("The resync period, workers, namespaces and selectors only change on restart", "path", path)
+		}
+		previous = c
+
+		current := currentConfiguration()
+		reloaded := *c
+		reloaded.ResyncPeriod, reloaded.Workers = current.ResyncPeriod, current.Workers
+		reloaded.Namespaces, reloaded.LabelSelector, reloaded.FieldSelector = current.Namespaces, current.LabelSelector, current.FieldSelector
+		if reflect.DeepEqual(&reloaded, current) {
+			return
+		}
+		applyConfiguration(&reloaded)
+		klog.InfoS("Reloaded configuration", "path", path)
+
+		for _, name := range controllerNames {
+			if requeue, ok := requeueOnEnable[name]; ok && reloaded.enabled(name) && !current.enabled(name) {
+				klog.InfoS("Requeuing the objects of the enabled controller", "controller", name)
+				requeue()
+			}
+		}
+	}, configurationReloadInterval, stopCh)
+}
+
+// startSettings returns the settings of 'c' that are only read when the
+// controller starts.
+func startSettings(c *controllerConfiguration) []interface{} {
+	return []interface{}{c.ResyncPeriod, c.Workers, c.Namespaces, c.LabelSelector, c.FieldSelector}
+}
+
+// retryLimiter is the exponential backoff of failed objects in a queue. It
+// follows the retry configuration in effect.
+type retryLimiter struct {
+	lock     sync.Mutex
+	failures map[interface{}]int
+}
+
+func newRetryLimiter() *retryLimiter {
+	return &retryLimiter{failures: map[interface{}]int{}}
+}
+
+func (r *retryLimiter) When(item interface{}) time.Duration {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	retry := currentConfiguration().Retry
+
+	exp := r.failures[item]
+	r.failures[item]++
+	backoff := float64(retry.BaseDelay.Nanoseconds()) * math.Pow(2, float64(exp))
+	if backoff > float64(retry.MaxDelay.Nanoseconds()) {
+		return retry.MaxDelay.Duration
+	}
+	return time.Duration(backoff)
+}
+
+func (r *retryLimiter) NumRequeues(item interface{}) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.failures[item]
+}
+
+func (r *retryLimiter) Forget(item interface{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.failures, item)
+}
@@ -0,0 +1,422 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/google/go-github/github"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// crashLoopLabel marks the issues opened for crash looping workloads, so
+// that they can be found again after a restart.
+const crashLoopLabel = "kube-custom-controller/crashloop"
+
+// crashLoopTitleSuffix follows the workload in the title of its issue.
+const crashLoopTitleSuffix = " is crash looping"
+
+// reportedMarker is the comment in the body of an issue that records the
+// restart counts reported, so that a restart of the controller does not
+// report them again.
+var reportedMarker = regexp.MustCompile(`\n*<!-- kube-custom-controller reported: (.*) -->`)
+
+var crashLoopQueue = newQueue("crashloops")
+
+// crashLoops holds the configuration and state of the crash loop reporter.
+// It is nil when reporting is disabled.
+var crashLoops *crashLoopConfig
+
+// crashLoopConfig selects the repository issues about crash looping
+// workloads are opened in.
+type crashLoopConfig struct {
+	owner      string
+	repository string
+
+	// healthyPeriod is how long a workload must have been healthy before
+	// its issue is closed.
+	healthyPeriod time.Duration
+
+	// logLines is how many lines of the previous container logs are
+	// included.
+	logLines int64
+
+	// lock guards the maps. The queue never hands the same workload to two
+	// workers at once, so it is not held while reporting.
+	lock sync.Mutex
+	// issues holds the open issue of every workload that has one. It is
+	// loaded from Github on first use. The restart counts reported are
+	// recorded in the body of the issue.
+	issues map[string]*github.Issue
+	// healthySince records when the workloads with an open issue were
+	// first seen healthy again.
+	healthySince map[string]time.Time
+}
+
+// failingContainer is what the issue of a workload is rendered with.
+type failingContainer struct {
+	Pod          string
+	Container    string
+	Reason       string
+	RestartCount int32
+	Terminated   *corev1.ContainerStateTerminated
+	Logs         string
+}
+
+var crashLoopTemplate = template.Must(template.New("crashloop").Funcs(template.FuncMap{
+	"quote": markdownQuote,
+	"fence": markdownFence,
+}).Parse(
+	"{{range .}}### Container `{{.Container}}` of pod `{{.Pod}}`: {{.Reason}}\n\n" +
+		"Restarted {{.RestartCount}} times.\n\n" +
+		"{{with .Terminated}}Last terminated with reason `{{.Reason}}`, exit code {{.ExitCode}}" +
+		"{{if .Signal}}, signal {{.Signal}}{{end}}, at {{.FinishedAt}}.\n" +
+		"{{if .Message}}\n{{quote .Message}}\n{{end}}\n{{end}}" +
+		"{{if .Logs}}{{$fence := fence .Logs}}<details><summary>Previous logs</summary>\n\n{{$fence}}\n{{.Logs}}\n{{$fence}}\n\n</details>\n\n{{end}}{{end}}"))
+
+// markdownQuote returns 'text' as a Markdown block quote, every line of it.
+func markdownQuote(text string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = "> " + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markdownFence returns a code fence that 'text' can not close, a run of
+// backticks longer than any in it, and at least three.
+func markdownFence(text string) string {
+	longest, run := 0, 0
+	for _, c := range text {
+		if c != '`' {
+			run = 0
+			continue
+		}
+		if run++; run > longest {
+			longest = run
+		}
+	}
+	if longest < 3 {
+		longest = 2
+	}
+	return strings.Repeat("`", longest+1)
+}
+
+// newCrashLoops parses the crash loop reporter flags. 'repository' has the
+// form 'owner/repo'.
+func newCrashLoops(repository string, healthyPeriod time.Duration, logLines int64) (*crashLoopConfig, error) {
+	parts := strings.Split(repository, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid repository %q, expected owner/repo", repository)
+	}
+	return &crashLoopConfig{
+		owner:         parts[0],
+		repository:    parts[1],
+		healthyPeriod: healthyPeriod,
+		logLines:      logLines,
+		healthySince:  map[string]time.Time{},
+	}, nil
+}
+
+// watchCrashLoops enqueues the workload of every pod that changes. It
+// returns the HasSynced functions of the informers it uses.
+func watchCrashLoops() []cache.InformerSynced {
+	notify := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		pod, ok := obj.(*corev1.Pod)
+		if !ok {
+			runtime.HandleError(fmt.Errorf("expected a pod but got %T", obj))
+			return
+		}
+		crashLoopQueue.Add(podWorkload(pod))
+	}
+	var synced []cache.InformerSynced
+	for _, pods := range kubeInformersOf("pods") {
+		pods.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    notify,
+			UpdateFunc: func(old, cur interface{}) { notify(cur) },
+			DeleteFunc: notify,
+		})
+		synced = append(synced, pods.HasSynced)
+	}
+
+	return synced
+}
+
+// requeueCrashLoops enqueues the workload of every pod.
+func requeueCrashLoops() {
+	for _, pods := range kubeInformersOf("pods") {
+		for _, obj := range pods.GetStore().List() {
+			if pod, ok := obj.(*corev1.Pod); ok {
+				crashLoopQueue.Add(podWorkload(pod))
+			}
+		}
+	}
+}
+
+// podWorkload returns the key of the workload 'pod' belongs to, like
+// 'namespace/Deployment.name'. Pods of a ReplicaSet are attributed to its
+// Deployment, so that the issue outlives rollouts.
+func podWorkload(pod *corev1.Pod) string {
+	kind, name := "Pod", pod.Name
+	if ref := metav1.GetControllerOf(pod); ref != nil {
+		kind, name = ref.Kind, ref.Name
+		hash := pod.Labels["pod-template-hash"]
+		if kind == "ReplicaSet" && hash != "" && strings.HasSuffix(name, "-"+hash) {
+			kind, name = "Deployment", strings.TrimSuffix(name, "-"+hash)
+		}
+	}
+	return pod.Namespace + "/" + kind + "." + name
+}
+
+// processCrashLoop checks the pods of the workload 'namespace/name', where
+// name is 'Kind.name', and reports them.
+func processCrashLoop(namespace, name string) error {
+	key := namespace + "/" + name
+	pods, err := kubeInformersFor(namespace).Core().V1().Pods().Lister().Pods(namespace).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("error listing pods in %q: %w", namespace, err)
+	}
+
+	var failing []failingContainer
+	for _, pod := range pods {
+		if podWorkload(pod) == key {
+			failing = append(failing, crashLoops.failingContainers(pod)...)
+		}
+	}
+
+	return crashLoops.report(key, failing)
+}
+
+// failingContainers returns the containers of 'pod' that are crash looping
+// or were OOMKilled within the healthy period.
+func (c *crashLoopConfig) failingContainers(pod *corev1.Pod) []failingContainer {
+	var failing []failingContainer
+	for _, status := range pod.Status.ContainerStatuses {
+		reason := ""
+		switch {
+		case status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff":
+			reason = "CrashLoopBackOff"
+		case status.State.Terminated != nil && status.State.Terminated.Reason == "OOMKilled":
+			reason = "OOMKilled"
+		case status.LastTerminationState.Terminated != nil && status.LastTerminationState.Terminated.Reason == "OOMKilled" &&
+			time.Since(status.LastTerminationState.Terminated.FinishedAt.Time) < c.healthyPeriod:
+			reason = "OOMKilled"
+		default:
+			continue
+		}
+		failing = append(failing, failingContainer{
+			Pod:          pod.Name,
+			Container:    status.Name,
+			Reason:       reason,
+			RestartCount: status.RestartCount,
+			Terminated:   status.LastTerminationState.Terminated,
+		})
+	}
+	return failing
+}
+
+// report opens an issue for the workload 'key' when it has failing
+// containers, and comments on it when they restarted since they were last
+// reported. Once the workload has been healthy for the healthy period, the
+// issue is closed.
+func (c *crashLoopConfig) report(key string, failing []failingContainer) error {
+	namespace := strings.SplitN(key, "/", 2)[0]
+	title := key + crashLoopTitleSuffix
+
+	issue, err := c.issue(key)
+	if err != nil {
+		return fmt.Errorf("error listing issues of %s/%s: %w", c.owner, c.repository, err)
+	}
+
+	if len(failing) == 0 {
+		if issue == nil {
+			return nil
+		}
+		c.lock.Lock()
+		since, ok := c.healthySince[key]
+		if !ok {
+			since = time.Now()
+			c.healthySince[key] = since
+		}
+		c.lock.Unlock()
+		if remaining := c.healthyPeriod - time.Since(since); remaining > 0 {
+			crashLoopQueue.AddAfter(key, remaining)
+			return nil
+		}
+		if _, err := sendComment(ctx, githubClient, c.owner, c.repository, issue.GetNumber(),
+			fmt.Sprintf("%s has been healthy for %s, closing.", key, c.healthyPeriod)); err != nil {
+			return err
+		}
+		state := "closed"
+		if _, _, err := githubClient.Issues.Edit(ctx, c.owner, c.repository, issue.GetNumber(), &github.IssueRequest{State: &state}); err != nil {
+			return fmt.Errorf("error closing issue #%d: %w", issue.GetNumber(), err)
+		}
+		c.lock.Lock()
+		delete(c.healthySince, key)
+		delete(c.issues, key)
+		c.lock.Unlock()
+		klog.InfoS("Closed crash loop issue, workload is healthy again", "workload", key, "issue", issue.GetNumber())
+		return nil
+	}
+	c.lock.Lock()
+	delete(c.healthySince, key)
+	c.lock.Unlock()
+
+	// only report containers that restarted since they were last reported.
+	// The counts of the containers that no longer fail are dropped, they
+	// report again once they restarted.
+	reported := reportedCounts(issue.GetBody())
+	counts := map[string]int32{}
+	var restarted []failingContainer
+	for _, container := range failing {
+		containerKey := container.Pod + "/" + container.Container
+		if count, ok := reported[containerKey]; ok && count >= container.RestartCount {
+			counts[containerKey] = count
+			continue
+		}
+		container.Logs = c.previousLogs(namespace, container)
+		restarted = append(restarted, container)
+		counts[containerKey] = container.RestartCount
+	}
+	if len(restarted) == 0 {
+		return nil
+	}
+
+	var body bytes.Buffer
+	if err := crashLoopTemplate.Execute(&body, restarted); err != nil {
+		return err
+	}
+
+	if issue == nil {
+		text := withReportedCounts(body.String(), counts)
+		issue, _, err = githubClient.Issues.Create(ctx, c.owner, c.repository, &github.IssueRequest{
+			Title:  &title,
+			Body:   &text,
+			Labels: &[]string{crashLoopLabel},
+		})
+		if err != nil {
+			return fmt.Errorf("error opening issue for %s: %w", key, err)
+		}
+		klog.InfoS("Opened crash loop issue", "workload", key, "issue", issue.GetNumber())
+	} else {
+		if _, err := sendComment(ctx, githubClient, c.owner, c.repository, issue.GetNumber(), body.String()); err != nil {
+			return fmt.Errorf("error commenting on issue #%d: %w", issue.GetNumber(), err)
+		}
+		klog.InfoS("Reported restarts on crash loop issue", "workload", key, "issue", issue.GetNumber())
+
+		// the comment is posted already, failing to record that only
+		// risks reporting the restarts again after a restart.
+		text := withReportedCounts(issue.GetBody(), counts)
+		recorded := *issue
+		recorded.Body = &text
+		if _, _, err := githubClient.Issues.Edit(ctx, c.owner, c.repository, issue.GetNumber(), &github.IssueRequest{Body: &text}); err != nil {
+			klog.ErrorS(err, "Error recording reported restarts in crash loop issue", "workload", key, "issue", issue.GetNumber())
+		}
+		issue = &recorded
+	}
+
+	c.lock.Lock()
+	c.issues[key] = issue
+	c.lock.Unlock()
+	return nil
+}
+
+// issue returns the open issue of the workload 'key', or nil if it has none.
+// The open issues are listed on first use.
+func (c *crashLoopConfig) issue(key string) (*github.Issue, error) {
+	c.lock.Lock()
+	issues := c.issues
+	c.lock.Unlock()
+	if issues == nil {
+		open, err := c.openIssues()
+		if err != nil {
+			return nil, err
+		}
+		c.lock.Lock()
+		if c.issues == nil {
+			c.issues = open
+		}
+		c.lock.Unlock()
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.issues[key], nil
+}
+
+// reportedCounts returns the restart counts recorded in the issue 'body', by
+// 'pod/container'.
+func reportedCounts(body string) map[string]int32 {
+	counts := map[string]int32{}
+	if match := reportedMarker.FindStringSubmatch(body); match != nil {
+		if err := json.Unmarshal([]byte(match[1]), &counts); err != nil {
+			klog.V(2).InfoS("Ignoring invalid reported restart counts", "reason", err.Error())
+		}
+	}
+	return counts
+}
+
+// withReportedCounts returns the issue 'body' with 'counts' recorded in it,
+// in place of the counts recorded before.
+func withReportedCounts(body string, counts map[string]int32) string {
+	// a map of strings to numbers always marshals.
+	data, _ := json.Marshal(counts)
+	return reportedMarker.ReplaceAllString(body, "") + fmt.Sprintf("\n\n<!-- kube-custom-controller reported: %s -->", data)
+}
+
+// openIssues returns the open issues about crash looping workloads, by
+// workload.
+func (c *crashLoopConfig) openIssues() (map[string]*github.Issue, error) {
+	open := map[string]*github.Issue{}
+	opts := &github.IssueListByRepoOptions{
+		State:       "open",
+		Labels:      []string{crashLoopLabel},
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		issues, resp, err := githubClient.Issues.ListByRepo(ctx, c.owner, c.repository, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			if strings.HasSuffix(issue.GetTitle(), crashLoopTitleSuffix) {
+				open[strings.TrimSuffix(issue.GetTitle(), crashLoopTitleSuffix)] = issue
+			}
+		}
+		if resp.NextPage == 0 {
+			return open, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// previousLogs returns the tail of the logs of the previous instance of
+// 'container'. Errors are returned in place of the logs, since the issue is
+// still useful without them.
+func (c *crashLoopConfig) previousLogs(namespace string, container failingContainer) string {
+	if c.logLines <= 0 {
+		return ""
+	}
+	raw, err := kubeClient.CoreV1().Pods(namespace).GetLogs(container.Pod, &corev1.PodLogOptions{
+		Container: container.Container,
+		Previous:  true,
+		TailLines: &c.logLines,
+	}).Do().Raw()
+	if err != nil {
+		return fmt.Sprintf("error getting logs: %s", err.Error())
+	}
+	return strings.TrimRight(string(raw), "\n")
+}
@@ -0,0 +1,26 @@
+package main
+
+import "testing"
+
+func TestMarkdownQuote(t *testing.T) {
+	if got, want := markdownQuote("panic: boom\n\ngoroutine 1\n"), "> panic: boom\n> \n> goroutine 1"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestMarkdownFence(t *testing.T) {
+	tests := []struct {
+		logs string
+		want string
+	}{
+		{"no backticks", "```"},
+		{"a `quoted` word", "```"},
+		{"```\nfenced\n```", "````"},
+		{"`````", "``````"},
+	}
+	for _, test := range tests {
+		if got := markdownFence(test.logs); got != test.want {
+			t.Errorf("expected fence %q for %q, got %q", test.want, test.logs, got)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	commentcontroller "github.com/nikhita/kube-custom-controller/pkg/controller"
+)
+
+var discussionQueue = newQueue("discussions")
+
+// processDiscussion retrieves the latest version of the Discussion
+// 'namespace/name' from the cache and syncs it.
+func processDiscussion(namespace, name string) error {
+	obj, err := informersFor(namespace).Github().V1().Discussions().Lister().Discussions(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		// deleted, the discussion on Github is kept.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting object '%s/%s' from api: %w", namespace, name, err)
+	}
+
+	return syncDiscussion(obj.DeepCopy())
+}
+
+// syncDiscussion posts a discussion, or a reply to one, unless that already
+// happened, and records the GraphQL node IDs in the resource status. Like
+// the comments of Comments, the posted discussion or reply is edited when
+// the title or body change. The other fields only take effect when posting.
+func syncDiscussion(discussion *v1.Discussion) error {
+	spec, status := discussion.Spec, discussion.Status
+	hash := discussionHash(spec)
+	switch {
+	case !status.Created:
+		var err error
+		if spec.ReplyTo != 0 {
+			status, err = replyToDiscussion(spec)
+		} else {
+			status, err = createDiscussion(spec)
+		}
+		if err != nil {
+			return err
+		}
+		status.Created = true
+		klog.InfoS("Posted discussion", "discussion", klog.KObj(discussion), "resourceVersion", discussion.ResourceVersion, "url", status.URL)
+
+	case status.BodyHash != hash:
+		if err := updateDiscussion(spec, status); err != nil {
+			return err
+		}
+		klog.InfoS("Edited discussion", "discussion", klog.KObj(discussion), "resourceVersion", discussion.ResourceVersion, "url", status.URL)
+
+	default:
+		klog.V(4).InfoS("Discussion is up to date", "discussion", klog.KObj(discussion), "resourceVersion", discussion.ResourceVersion)
+		return nil
+	}
+
+	status.BodyHash = hash
+	discussion.Status = status
+	if _, err := cl.GithubV1().Discussions(discussion.Namespace).Update(discussion); err != nil {
+		return fmt.Errorf("error saving update to Discussion resource: %w", err)
+	}
+	klog.V(2).InfoS("Saved status of Discussion", "discussion", klog.KObj(discussion))
+	return nil
+}
+
+// createDiscussion starts a new discussion in the category named by
+// spec.Category.
+func createDiscussion(spec v1.DiscussionSpec) (v1.DiscussionStatus, error) {
+	var q struct {
+		Repository struct {
+			ID                   githubv4.ID
+			DiscussionCategories struct {
+				Nodes []struct {
+					ID   githubv4.ID
+					Name string
+					Slug string
+				}
+			} `graphql:"discussionCategories(first: 100)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]interface{}{
+		"owner": githubv4.String(spec.Owner),
+		"name":  githubv4.String(spec.Repository),
+	}
+	if err := githubV4Client.Query(ctx, &q, variables); err != nil {
+		return v1.DiscussionStatus{}, fmt.Errorf("error looking up discussion categories of %s/%s: %w", spec.Owner, spec.Repository, err)
+	}
+
+	var categoryID githubv4.ID
+	for _, category := range q.Repository.DiscussionCategories.Nodes {
+		if strings.EqualFold(category.Name, spec.Category) || category.Slug == spec.Category {
+			categoryID = category.ID
+			break
+		}
+	}
+	if categoryID == nil {
+		return v1.DiscussionStatus{}, fmt.Errorf("discussion category %q not found in %s/%s", spec.Category, spec.Owner, spec.Repository)
+	}
+
+	var m struct {
+		CreateDiscussion struct {
+			Discussion struct {
+				ID  githubv4.ID
+				URL string
+			}
+		} `graphql:"createDiscussion(input: $input)"`
+	}
+	input := githubv4.CreateDiscussionInput{
+		RepositoryID: q.Repository.ID,
+		CategoryID:   categoryID,
+		Title:        githubv4.String(spec.Title),
+		Body:         githubv4.String(spec.Body),
+	}
+	if err := githubV4Client.Mutate(ctx, &m, input, nil); err != nil {
+		return v1.DiscussionStatus{}, fmt.Errorf("error creating discussion in %s/%s: %w", spec.Owner, spec.Repository, err)
+	}
+
+	created := m.CreateDiscussion.Discussion
+	return v1.DiscussionStatus{
+		DiscussionNodeID: nodeID(created.ID),
+		NodeID:           nodeID(created.ID),
+		URL:              created.URL,
+	}, nil
+}
+
+// replyToDiscussion adds a comment to the existing discussion number
+// spec.ReplyTo.
+func replyToDiscussion(spec v1.DiscussionSpec) (v1.DiscussionStatus, error) {
+	var q struct {
+		Repository struct {
+			Discussion struct {
+				ID githubv4.ID
+			} `graphql:"discussion(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(spec.Owner),
+		"name":   githubv4.String(spec.Repository),
+		"number": githubv4.Int(spec.ReplyTo),
+	}
+	if err := githubV4Client.Query(ctx, &q, variables); err != nil {
+		return v1.DiscussionStatus{}, fmt.Errorf("error looking up discussion %s/%s#%d: %w", spec.Owner, spec.Repository, spec.ReplyTo, err)
+	}
+
+	var m struct {
+		AddDiscussionComment struct {
+			Comment struct {
+				ID  githubv4.ID
+				URL string
+			}
+		} `graphql:"addDiscussionComment(input: $input)"`
+	}
+	input := githubv4.AddDiscussionCommentInput{
+		DiscussionID: q.Repository.Discussion.ID,
+		Body:         githubv4.String(spec.Body),
+	}
+	if err := githubV4Client.Mutate(ctx, &m, input, nil); err != nil {
+		return v1.DiscussionStatus{}, fmt.Errorf("error replying to discussion %s/%s#%d: %w", spec.Owner, spec.Repository, spec.ReplyTo, err)
+	}
+
+	reply := m.AddDiscussionComment.Comment
+	return v1.DiscussionStatus{
+		DiscussionNodeID: nodeID(q.Repository.Discussion.ID),
+		NodeID:           nodeID(reply.ID),
+		URL:              reply.URL,
+	}, nil
+}
+
+// updateDiscussion replaces the title and body of the discussion whose IDs
+// 'status' holds with those of 'spec'. A reply, whose node ID differs from
+// the one of its discussion, only has its body replaced.
+func updateDiscussion(spec v1.DiscussionSpec, status v1.DiscussionStatus) error {
+	body := githubv4.String(spec.Body)
+	if status.NodeID != status.DiscussionNodeID {
+		var m struct {
+			UpdateDiscussionComment struct {
+				Comment struct {
+					ID githubv4.ID
+				}
+			} `graphql:"updateDiscussionComment(input: $input)"`
+		}
+		input := githubv4.UpdateDiscussionCommentInput{
+			CommentID: githubv4.ID(status.NodeID),
+			Body:      body,
+		}
+		if err := githubV4Client.Mutate(ctx, &m, input, nil); err != nil {
+			return fmt.Errorf("error editing reply %s: %w", status.URL, err)
+		}
+		return nil
+	}
+
+	var m struct {
+		UpdateDiscussion struct {
+			Discussion struct {
+				ID githubv4.ID
+			}
+		} `graphql:"updateDiscussion(input: $input)"`
+	}
+	title := githubv4.String(spec.Title)
+	input := githubv4.UpdateDiscussionInput{
+		DiscussionID: githubv4.ID(status.NodeID),
+		Title:        &title,
+		Body:         &body,
+	}
+	if err := githubV4Client.Mutate(ctx, &m, input, nil); err != nil {
+		return fmt.Errorf("error editing discussion %s: %w", status.URL, err)
+	}
+	return nil
+}
+
+// discussionHash returns the hash of the title and body of 'spec' recorded
+// in DiscussionStatus.
+func discussionHash(spec v1.DiscussionSpec) string {
+	return commentcontroller.MessageHash(spec.Title + "\x00" + spec.Body)
+}
+
+// nodeID converts a GraphQL ID into the string we store in status.
+func nodeID(id githubv4.ID) string {
+	if id == nil {
+		return ""
+	}
+	return fmt.Sprint(id)
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/google/go-github/github"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+)
+
+const (
+	// forwarded events are annotated with the comment they were reported
+	// in and the count reported, so that a restart does not report them
+	// again.
+	eventCommentAnnotation = "github.k8s.io/forwarded-comment"
+	eventCountAnnotation   = "github.k8s.io/forwarded-count"
+)
+
+var eventQueue = newQueue("events")
+
+// eventBridge holds the configuration of the event bridge. It is nil when
+// forwarding of events is disabled.
+var eventBridge *eventBridgeConfig
+
+// eventBridgeConfig selects the Warning events that are forwarded and the
+// issue they are forwarded to. An empty selector matches everything.
+type eventBridgeConfig struct {
+	issue      v1.IssueReference
+	kinds      map[string]bool
+	namespaces map[string]bool
+	reasons    map[string]bool
+
+	// window is how long repeated events are aggregated into the same
+	// comment.
+	window time.Duration
+
+	// lock guards aggregates. The comment of an aggregate is reported
+	// holding the lock of the aggregate only.
+	lock       sync.Mutex
+	aggregates map[string]*eventAggregate
+}
+
+// eventAggregate is the comment all events with the same involved object
+// and reason are reported in during one window.
+type eventAggregate struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Reason    string
+	Message   string
+	Count     int32
+	FirstSeen time.Time
+	LastSeen  time.Time
+
+	commentID int64
+	// counts holds the count of every event aggregated, by UID. Events
+	// repeated by the kubelet are updated in place, so only their count
+	// changes.
+	counts map[string]int32
+
+	// lock serializes the events reported in the aggregate. It is a
+	// pointer, so that the aggregate can be copied to restore it.
+	lock *sync.Mutex
+}
+
+var eventCommentTemplate = template.Must(template.New("event").Parse(
+	"**Warning** `{{.Reason}}` on {{.Kind}} `{{if .Namespace}}{{.Namespace}}/{{end}}{{.Name}}`\n\n" +
+		"> {{.Message}}\n\n" +
+		"Seen {{.Count}} times between {{.FirstSeen.Format \"2006-01-02 15:04:05 MST\"}} and {{.LastSeen.Format \"2006-01-02 15:04:05 MST\"}}.\n"))
+
+// newEventBridge parses the event bridge flags. 'issue' has the form
+// 'owner/repo#number' and the selectors are comma separated lists.
+func newEventBridge(issue, kinds, namespaces, reasons string, window time.Duration) (*eventBridgeConfig, error) {
+	ref, err := parseIssueReference(issue)
+	if err != nil {
+		return nil, err
+	}
+	if window <= 0 {
+		return nil, fmt.Errorf("event aggregation window must be positive, got %s", window)
+	}
+	return &eventBridgeConfig{
+		issue:      ref,
+		kinds:      stringSet(kinds),
+		namespaces: stringSet(namespaces),
+		reasons:    stringSet(reasons),
+		window:     window,
+		aggregates: map[string]*eventAggregate{},
+	}, nil
+}
+
+// parseIssueReference parses an issue given as 'owner/repo#number'.
+func parseIssueReference(s string) (v1.IssueReference, error) {
+	repo, number := s, ""
+	if i := strings.LastIndex(s, "#"); i >= 0 {
+		repo, number = s[:i], s[i+1:]
+	}
+	parts := strings.Split(repo, "/")
+	n, err := strconv.Atoi(number)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || err != nil {
+		return v1.IssueReference{}, fmt.Errorf("invalid issue %q, expected owner/repo#number", s)
+	}
+	return v1.IssueReference{Owner: parts[0], Repository: parts[1], Number: n}, nil
+}
+
+// stringSet returns the elements of the comma separated list 's'.
+func stringSet(s string) map[string]bool {
+	set := map[string]bool{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			set[item] = true
+		}
+	}
+	return set
+}
+
+// matches returns whether 'event' is a Warning selected for forwarding.
+func (b *eventBridgeConfig) matches(event *corev1.Event) bool {
+	if event.Type != corev1.EventTypeWarning {
+		return false
+	}
+	selected := func(set map[string]bool, value string) bool {
+		return len(set) == 0 || set[value]
+	}
+	return selected(b.kinds, event.InvolvedObject.Kind) &&
+		selected(b.namespaces, event.InvolvedObject.Namespace) &&
+		selected(b.reasons, event.Reason)
+}
+
+// processEvent retrieves the latest version of the Event 'namespace/name'
+// from the cache and forwards it.
+func processEvent(namespace, name string) error {
+	obj, err := kubeInformersFor(namespace).Core().V1().Events().Lister().Events(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		// expired events have been reported already.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting object '%s/%s' from api: %w", namespace, name, err)
+	}
+
+	return eventBridge.forward(obj)
+}
+
+// forward reports 'event' in the comment of its aggregate, creating the
+// comment when the aggregate is new and editing it otherwise. Events last
+// seen before the current window are ignored, and events are annotated
+// with the comment they were reported in, so that a restart does not report
+// the history kept by the cluster again.
+func (b *eventBridgeConfig) forward(event *corev1.Event) error {
+	if !b.matches(event) {
+		return nil
+	}
+	firstSeen, lastSeen := event.FirstTimestamp.Time, event.LastTimestamp.Time
+	if lastSeen.IsZero() {
+		lastSeen = event.EventTime.Time
+	}
+	if firstSeen.IsZero() {
+		firstSeen = lastSeen
+	}
+	now := time.Now()
+	if now.Sub(lastSeen) > b.window {
+		return nil
+	}
+
+	involved := event.InvolvedObject
+	key := strings.Join([]string{involved.Kind, involved.Namespace, involved.Name, event.Reason}, "/")
+
+	b.lock.Lock()
+	// forget aggregates whose window is over, so that the next event for
+	// them starts a new comment.
+	for key, aggregate := range b.aggregates {
+		if now.Sub(aggregate.FirstSeen) > b.window {
+			delete(b.aggregates, key)
+		}
+	}
+	aggregate, ok := b.aggregates[key]
+	if !ok {
+		aggregate = &eventAggregate{
+			Kind:      involved.Kind,
+			Namespace: involved.Namespace,
+			Name:      involved.Name,
+			Reason:    event.Reason,
+			FirstSeen: firstSeen,
+			counts:    map[string]int32{},
+			lock:      &sync.Mutex{},
+		}
+		b.aggregates[key] = aggregate
+	}
+	b.lock.Unlock()
+
+	aggregate.lock.Lock()
+	defer aggregate.lock.Unlock()
+
+	count := event.Count
+	if count < 1 {
+		count = 1
+	}
+	uid := string(event.UID)
+
+	// events reported before a restart continue in the comment they were
+	// reported in.
+	if _, counted := aggregate.counts[uid]; !counted && aggregate.commentID == 0 {
+		if commentID, reported := forwardedAs(event); commentID != 0 && reported >= count {
+			aggregate.commentID = commentID
+			aggregate.counts[uid] = reported
+			aggregate.Count, aggregate.Message, aggregate.LastSeen = reported, event.Message, lastSeen
+			return nil
+		}
+	}
+	previous := *aggregate
+	previousCount, counted := aggregate.counts[uid]
+	aggregate.counts[uid] = count
+	aggregate.Count = 0
+	for _, c := range aggregate.counts {
+		aggregate.Count += c
+	}
+	aggregate.Message = event.Message
+	if firstSeen.Before(aggregate.FirstSeen) {
+		aggregate.FirstSeen = firstSeen
+	}
+	if lastSeen.After(aggregate.LastSeen) {
+		aggregate.LastSeen = lastSeen
+	}
+	if ok && previous.Count == aggregate.Count && previous.Message == aggregate.Message {
+		return nil
+	}
+
+	var body bytes.Buffer
+	if err := eventCommentTemplate.Execute(&body, aggregate); err != nil {
+		return err
+	}
+	message := body.String()
+
+	// restore restores the previous state, so that the retry reports the
+	// event again.
+	restore := func() {
+		*aggregate = previous
+		if counted {
+			aggregate.counts[uid] = previousCount
+		} else {
+			delete(aggregate.counts, uid)
+		}
+	}
+
+	issue := b.issue
+	if aggregate.commentID == 0 {
+		comment, err := sendComment(ctx, githubClient, issue.Owner, issue.Repository, issue.Number, message)
+		if err != nil {
+			restore()
+			return fmt.Errorf("error forwarding event %s: %w", key, err)
+		}
+		aggregate.commentID = comment.GetID()
+		klog.InfoS("Forwarded Warning event", "event", key, "issue", fmt.Sprintf("%s/%s#%d", issue.Owner, issue.Repository, issue.Number))
+	} else {
+		_, _, err := githubClient.Issues.EditComment(ctx, issue.Owner, issue.Repository, aggregate.commentID, &github.IssueComment{Body: &message})
+		if err != nil {
+			restore()
+			return fmt.Errorf("error updating comment for event %s: %w", key, err)
+		}
+		klog.V(2).InfoS("Updated comment for Warning event", "event", key, "count", aggregate.Count)
+	}
+
+	// the comment is posted already, failing to record that only risks
+	// reporting the event again after a restart.
+	if err := recordForwarded(event, aggregate.commentID, count); err != nil {
+		klog.ErrorS(err, "Error recording forwarded event", "event", klog.KObj(event))
+	}
+	return nil
+}
+
+// forwardedAs returns the comment 'event' was reported in and the count
+// reported, or 0 if it was not reported yet.
+func forwardedAs(event *corev1.Event) (int64, int32) {
+	commentID, err := strconv.ParseInt(event.Annotations[eventCommentAnnotation], 10, 64)
+	if err != nil {
+		return 0, 0
+	}
+	count, err := strconv.ParseInt(event.Annotations[eventCountAnnotation], 10, 32)
+	if err != nil {
+		return 0, 0
+	}
+	return commentID, int32(count)
+}
+
+// recordForwarded annotates 'event' with the comment it was reported in and
+// the count reported.
+func recordForwarded(event *corev1.Event, commentID int64, count int32) error {
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q,%q:%q}}}`,
+		eventCommentAnnotation, strconv.FormatInt(commentID, 10), eventCountAnnotation, strconv.FormatInt(int64(count), 10))
+	_, err := kubeClient.CoreV1().Events(event.Namespace).Patch(event.Name, types.MergePatchType, []byte(patch))
+	return err
+}
+
+// String describes the selectors of the bridge, for logging.
+func (b *eventBridgeConfig) String() string {
+	list := func(set map[string]bool) string {
+		if len(set) == 0 {
+			return "*"
+		}
+		var items []string
+		for item := range set {
+			items = append(items, item)
+		}
+		sort.Strings(items)
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprintf("kinds=%s namespaces=%s reasons=%s window=%s to %s/%s#%d",
+		list(b.kinds), list(b.namespaces), list(b.reasons), b.window, b.issue.Owner, b.issue.Repository, b.issue.Number)
+}
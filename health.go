@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	commentcontroller "github.com/nikhita/kube-custom-controller/pkg/controller"
+)
+
+const (
+	// githubProbeInterval is how often the Github API is called when the
+	// workers did not call it since.
+	githubProbeInterval = time.Second * 30
+
+	// githubReadyMaxAge is how recent the last successful Github API call
+	// must be for the controller to be ready.
+	githubReadyMaxAge = time.Minute * 2
+)
+
+// probes backs the /healthz and /readyz endpoints.
+var probes = &health{}
+
+// health tracks what the liveness and readiness of the controller depend
+// on.
+type health struct {
+	// synced reports whether the informers finished their initial sync.
+	synced []cache.InformerSynced
+	// stuckTimeout is how long a worker may process a single object before
+	// it is considered stuck.
+	stuckTimeout time.Duration
+
+	lock          sync.Mutex
+	syncing       map[*heartbeat]bool
+	githubSuccess time.Time
+}
+
+// heartbeat records since when a worker of a controller is syncing an
+// object.
+type heartbeat struct {
+	controller string
+	busySince  time.Time
+}
+
+// register serves the probes on 'mux'.
+func (h *health) register(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", h.healthz)
+	mux.HandleFunc("/readyz", h.readyz)
+}
+
+// gate answers the requests to the endpoints of 'handler', other than the
+// probes and metrics, with a server error until the informers are synced.
+func (h *health) gate(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/healthz", "/readyz", "/metrics":
+		default:
+			for _, synced := range h.synced {
+				if !synced() {
+					http.Error(w, "informers are not synced yet", http.StatusServiceUnavailable)
+					return
+				}
+			}
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// started records that a worker of the controller 'name' started syncing an
+// object. The returned function records that it finished.
+func (h *health) started(name string) func() {
+	beat := &heartbeat{controller: name, busySince: time.Now()}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.syncing == nil {
+		h.syncing = map[*heartbeat]bool{}
+	}
+	h.syncing[beat] = true
+
+	return func() {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		delete(h.syncing, beat)
+	}
+}
+
+// githubSucceeded records a successful call to the Github API.
+func (h *health) githubSucceeded() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.githubSuccess = time.Now()
+}
+
+// run calls the rate limit endpoint of the Github API, which does not count
+// against the rate limit, whenever no other call succeeded for a while, so
+// that readiness reflects whether Github is reachable while we are idle.
+func (h *health) run(stopCh <-chan struct{}) {
+	wait.Until(func() {
+		h.lock.Lock()
+		last := h.githubSuccess
+		h.lock.Unlock()
+		if time.Since(last) < githubProbeInterval {
+			return
+		}
+		if _, _, err := githubClient.RateLimits(ctx); err != nil {
+			klog.ErrorS(err, "Error reaching the Github API", "githubRequestID", commentcontroller.RequestID(err))
+		}
+	}, githubProbeInterval, stopCh)
+}
+
+// healthz fails while a worker is stuck processing an object, so that the
+// controller is restarted.
+func (h *health) healthz(w http.ResponseWriter, req *http.Request) {
+	h.lock.Lock()
+	var stuck []string
+	for beat := range h.syncing {
+		if time.Since(beat.busySince) > h.stuckTimeout {
+			stuck = append(stuck, fmt.Sprintf("a worker of %s is processing the same object since %s", beat.controller, beat.busySince.Format(time.RFC3339)))
+		}
+	}
+	h.lock.Unlock()
+
+	if len(stuck) > 0 {
+		http.Error(w, strings.Join(stuck, "\n"), http.StatusInternalServerError)
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
+
+// readyz fails until the informers are synced, and while the Github API
+// could not be reached recently.
+func (h *health) readyz(w http.ResponseWriter, req *http.Request) {
+	var failed []string
+	for _, synced := range h.synced {
+		if !synced() {
+			failed = append(failed, "informers are not synced")
+			break
+		}
+	}
+
+	h.lock.Lock()
+	last := h.githubSuccess
+	h.lock.Unlock()
+	if last.IsZero() {
+		failed = append(failed, "the Github API was not reached yet")
+	} else if age := time.Since(last); age > githubReadyMaxAge {
+		failed = append(failed, fmt.Sprintf("the Github API was last reached %s ago", age.Round(time.Second)))
+	}
+
+	if len(failed) > 0 {
+		http.Error(w, strings.Join(failed, "\n"), http.StatusServiceUnavailable)
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
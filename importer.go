@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/github"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	commentcontroller "github.com/nikhita/kube-custom-controller/pkg/controller"
+)
+
+// commentImporter mirrors the comments of issues into observed Comments by
+// polling them, for clusters that can not receive the Github webhook.
+type commentImporter struct {
+	issues    []v1.IssueReference
+	namespace string
+	interval  time.Duration
+
+	// login is the user of the API token. Its comments are never imported.
+	login string
+	// started is when the importer was started. Only comments posted since
+	// are run as ChatOps commands.
+	started time.Time
+	// since holds the time of the latest update seen on each issue.
+	since map[v1.IssueReference]time.Time
+}
+
+// newCommentImporter sets up the importer of the comma separated list of
+// issues 'issues', given as 'owner/repo#number'.
+func newCommentImporter(issues, namespace string, interval time.Duration) (*commentImporter, error) {
+	importer := &commentImporter{
+		namespace: namespace,
+		interval:  interval,
+		started:   time.Now(),
+		since:     map[v1.IssueReference]time.Time{},
+	}
+	for _, issue := range strings.Split(issues, ",") {
+		ref, err := parseIssueReference(strings.TrimSpace(issue))
+		if err != nil {
+			return nil, err
+		}
+		importer.issues = append(importer.issues, ref)
+	}
+
+	login, err := tokenLogin()
+	if err != nil {
+		return nil, err
+	}
+	importer.login = login
+	return importer, nil
+}
+
+// run polls the issues every interval until 'stopCh' is closed.
+func (i *commentImporter) run(stopCh <-chan struct{}) {
+	wait.Until(func() {
+		for _, issue := range i.issues {
+			if err := i.importIssue(issue); err != nil {
+				runtime.HandleError(fmt.Errorf("error importing comments of %s/%s#%d: %w", issue.Owner, issue.Repository, issue.Number, err))
+			}
+		}
+	}, i.interval, stopCh)
+}
+
+// importIssue imports the comments of 'issue' that were posted or edited
+// since it was last polled.
+func (i *commentImporter) importIssue(issue v1.IssueReference) error {
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	if since, ok := i.since[issue]; ok {
+		opts.Since = &since
+	}
+
+	latest := i.since[issue]
+	for {
+		comments, resp, err := githubClient.Issues.ListComments(ctx, issue.Owner, issue.Repository, issue.Number, opts)
+		if err != nil {
+			return err
+		}
+		for _, remote := range comments {
+			if err := i.importComment(issue, remote); err != nil {
+				return err
+			}
+			if updated := remote.GetUpdatedAt().Time; updated.After(latest) {
+				latest = updated
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	// only move on once all pages were imported, so that a failed poll is
+	// repeated in full.
+	i.since[issue] = latest
+	return nil
+}
+
+// importComment creates or updates the observed Comment of 'remote'.
+// Comments posted for Comments, and by the user of the API token, are
+// skipped.
+func (i *commentImporter) importComment(issue v1.IssueReference, remote *github.IssueComment) error {
+	if remote.GetUser().GetLogin() == i.login {
+		return nil
+	}
+
+	objs, err := commentsByID(remote.GetID())
+	if err != nil {
+		return err
+	}
+
+	if len(objs) == 0 {
+		comment := observedComment(i.namespace, issue.Owner, issue.Repository, issue.Number, remote)
+		if _, err := cl.GithubV1().Comments(i.namespace).Create(comment); err != nil {
+			if errors.IsAlreadyExists(err) {
+				return nil
+			}
+			return fmt.Errorf("error importing comment %d: %w", remote.GetID(), err)
+		}
+		klog.InfoS("Imported comment", "commentID", remote.GetID(), "author", comment.Status.Author, "comment", klog.KObj(comment))
+
+		if chatOps != nil && remote.GetCreatedAt().After(i.started) {
+			chatOps.enqueue(remote.GetID(), issue.Owner, issue.Repository, issue.Number, remote.GetUser().GetLogin(), remote.GetBody())
+		}
+		return nil
+	}
+
+	for _, obj := range objs {
+		existing := obj.(*v1.Comment)
+		if !existing.Spec.Observed || existing.Spec.Message == remote.GetBody() {
+			continue
+		}
+		comment := existing.DeepCopy()
+		comment.Spec.Message = remote.GetBody()
+		comment.Status.MessageHash = commentcontroller.MessageHash(remote.GetBody())
+		comment.Status.UpdatedAt = metav1.NewTime(remote.GetUpdatedAt().Time)
+		if _, err := cl.GithubV1().Comments(comment.Namespace).Update(comment); err != nil {
+			return fmt.Errorf("error saving update to Comment resource: %w", err)
+		}
+		klog.InfoS("Updated imported Comment", "comment", klog.KObj(comment), "commentID", remote.GetID())
+		recorder.Eventf(comment, corev1.EventTypeNormal, "Updated", "Comment %s was edited on Github", comment.Status.URL)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/klog/v2"
+)
+
+// leaderElection takes part in the election for the Lease 'namespace/name',
+// so that only one of the replicas of the controller runs the workers. It
+// returns a channel that is closed once we are the leader, and a function
+// that gives up the lease, to be called once the workers were shut down.
+//
+// Losing the lease while running exits the controller, so that it never
+// works next to the new leader. It rejoins the election when it is
+// restarted.
+func leaderElection(namespace, name string, leaseDuration, renewDeadline, retryPeriod time.Duration) (<-chan struct{}, func(), error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting hostname: %w", err)
+	}
+	identity := hostname + "_" + string(uuid.NewUUID())
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Client: kubeClient.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
+	}
+
+	leading := make(chan struct{})
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:          lock,
+		LeaseDuration: leaseDuration,
+		RenewDeadline: renewDeadline,
+		RetryPeriod:   retryPeriod,
+		// give up the lease on shutdown, so that another replica takes over
+		// right away instead of waiting for it to expire.
+		ReleaseOnCancel: true,
+		Name:            name,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				klog.InfoS("Became the leader", "lease", klog.KRef(namespace, name), "identity", identity)
+				leader.Set(1)
+				close(leading)
+			},
+			OnStoppedLeading: func() {
+				leader.Set(0)
+				select {
+				case <-stopCh:
+					klog.InfoS("Left leader election", "lease", klog.KRef(namespace, name))
+				default:
+					klog.ErrorS(nil, "Lost leadership, exiting", "lease", klog.KRef(namespace, name))
+					klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+				}
+			},
+			OnNewLeader: func(current string) {
+				if current != identity {
+					klog.InfoS("New leader elected", "lease", klog.KRef(namespace, name), "leader", current)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	electionCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		elector.Run(electionCtx)
+	}()
+	klog.InfoS("Joined leader election", "lease", klog.KRef(namespace, name), "identity", identity)
+
+	release := func() {
+		cancel()
+		<-done
+	}
+	return leading, release, nil
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/zapr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"k8s.io/klog/v2"
+)
+
+// setupLogging sets up klog, which all logs go through, to write in
+// 'format': 'text' for the klog text format, or 'json' for one JSON object
+// per line. Both honor --v.
+func setupLogging(format string) error {
+	switch format {
+	case "text":
+		return nil
+	case "json":
+	default:
+		return fmt.Errorf("unknown log format %q, expected text or json", format)
+	}
+
+	verbosity := 0
+	if v := flag.Lookup("v"); v != nil {
+		verbosity, _ = strconv.Atoi(v.Value.String())
+	}
+
+	// zapr logs V(n) at the zap level -n.
+	config := zap.NewProductionConfig()
+	config.Level = zap.NewAtomicLevelAt(zapcore.Level(-verbosity))
+	config.Sampling = nil
+	config.EncoderConfig.TimeKey = "ts"
+	config.EncoderConfig.EncodeTime = zapcore.RFC3339NanoTimeEncoder
+	logger, err := config.Build()
+	if err != nil {
+		return err
+	}
+	klog.SetLogger(zapr.NewLogger(logger))
+	return nil
+}
@@ -4,39 +4,89 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"log"
+	"net/http"
 	"os"
+	"os/signal"
 	"reflect"
+	"sync"
+	"syscall"
 	"time"
 
 	"golang.org/x/oauth2"
 
 	"github.com/google/go-github/github"
+	"github.com/shurcooL/githubv4"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
 
-	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
 	"github.com/nikhita/kube-custom-controller/pkg/client"
-	factory "github.com/nikhita/kube-custom-controller/pkg/informers/externalversions"
+	"github.com/nikhita/kube-custom-controller/pkg/client/scheme"
+	commentcontroller "github.com/nikhita/kube-custom-controller/pkg/controller"
 )
 
+// the clients below are set up once in main, before any worker is started,
+// and are never replaced after. They are all safe for concurrent use, so the
+// workers share them without locking.
 var (
 	ctx context.Context
 
 	githubClient *github.Client
 
-	queue = workqueue.NewRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute))
+	// githubV4Client talks to the GraphQL API, for the features that are not
+	// available through the REST API, like Discussions.
+	githubV4Client *githubv4.Client
 
+	// stopCh is closed when the controller is asked to shut down.
 	stopCh = make(chan struct{})
 
-	sharedFactory factory.SharedInformerFactory
-
 	cl client.Interface
+
+	kubeClient kubernetes.Interface
+
+	// httpMux serves the HTTP endpoints of the controller, like the
+	// Alertmanager receiver.
+	httpMux = http.NewServeMux()
+
+	// dynamicClient reads resources we have no generated client for, like
+	// the ones NotificationRules watch.
+	dynamicClient dynamic.Interface
+
+	// recorder records what happened to Comments as Events on them.
+	recorder record.EventRecorder
 )
 
+// controller ties the informers of every watched namespace to the queue
+// their changes are added to, and to the function that processes the keys
+// read off that queue. There are no informers for queues that are filled by
+// handlers of their own, these have a requeue function adding all their keys
+// instead. The name labels its metrics.
+type controller struct {
+	name      string
+	informers []cache.SharedIndexInformer
+	queue     workqueue.RateLimitingInterface
+	process   func(namespace, name string) error
+	requeue   func()
+}
+
+// newQueue returns the queue of the controller 'name'. Its metrics are
+// exported under that name, and failed objects are retried following the
+// retry configuration in effect.
+func newQueue(name string) workqueue.RateLimitingInterface {
+	useWorkqueueMetrics()
+	return workqueue.NewNamedRateLimitingQueue(newRetryLimiter(), name)
+}
+
 func main() {
 	kubeconfig := ""
 	flag.StringVar(&kubeconfig, "kubeconfig", kubeconfig, "kubeconfig file")
@@ -44,7 +94,166 @@ func main() {
 	githubToken := ""
 	flag.StringVar(&githubToken, "token", githubToken, "Github API token")
 
+	eventIssue := ""
+	flag.StringVar(&eventIssue, "event-issue", eventIssue, "Github issue 'owner/repo#number' Warning events are forwarded to. Events are not forwarded if empty")
+
+	eventKinds := ""
+	flag.StringVar(&eventKinds, "event-kinds", eventKinds, "comma separated kinds of involved objects whose events are forwarded (default all)")
+
+	eventNamespaces := ""
+	flag.StringVar(&eventNamespaces, "event-namespaces", eventNamespaces, "comma separated namespaces whose events are forwarded (default all)")
+
+	eventReasons := ""
+	flag.StringVar(&eventReasons, "event-reasons", eventReasons, "comma separated reasons of the events that are forwarded (default all)")
+
+	eventWindow := time.Minute * 10
+	flag.DurationVar(&eventWindow, "event-window", eventWindow, "how long repeated events are aggregated into the same comment")
+
+	crashLoopRepository := ""
+	flag.StringVar(&crashLoopRepository, "crashloop-repository", crashLoopRepository, "Github repository 'owner/repo' issues about crash looping workloads are opened in. No issues are opened if empty")
+
+	crashLoopHealthyPeriod := time.Minute * 10
+	flag.DurationVar(&crashLoopHealthyPeriod, "crashloop-healthy-period", crashLoopHealthyPeriod, "how long a workload must have been healthy before its issue is closed")
+
+	crashLoopLogLines := int64(50)
+	flag.Int64Var(&crashLoopLogLines, "crashloop-log-lines", crashLoopLogLines, "how many lines of the previous container logs are included in issues")
+
+	listenAddress := ""
+	flag.StringVar(&listenAddress, "listen-address", listenAddress, "address the HTTP endpoints are served on, like :8080")
+
+	alertmanagerIssue := ""
+	flag.StringVar(&alertmanagerIssue, "alertmanager-issue", alertmanagerIssue, "Github issue 'owner/repo#number' Alertmanager notifications are posted on by default. The receiver is served on /alertmanager if set")
+
+	alertmanagerNamespace := "default"
+	flag.StringVar(&alertmanagerNamespace, "alertmanager-namespace", alertmanagerNamespace, "namespace the Comments for Alertmanager notifications are created in")
+
+	alertmanagerToken := ""
+	flag.StringVar(&alertmanagerToken, "alertmanager-token", alertmanagerToken, "bearer token Alertmanager notifications must carry (default $ALERTMANAGER_TOKEN)")
+
+	alertmanagerRepositories := ""
+	flag.StringVar(&alertmanagerRepositories, "alertmanager-repositories", alertmanagerRepositories, "comma separated repositories 'owner/repo' the github_repository label of alerts may name, in addition to the one of --alertmanager-issue")
+
+	tlsCertFile := ""
+	flag.StringVar(&tlsCertFile, "tls-cert-file", tlsCertFile, "certificate the HTTP endpoints are served with over HTTPS")
+
+	tlsKeyFile := ""
+	flag.StringVar(&tlsKeyFile, "tls-key-file", tlsKeyFile, "private key of --tls-cert-file")
+
+	webhookSecret := ""
+	flag.StringVar(&webhookSecret, "webhook-secret", webhookSecret, "secret of the Github webhook. The receiver is served on /github if set")
+
+	webhookMirror := false
+	flag.BoolVar(&webhookMirror, "webhook-mirror", webhookMirror, "create observed Comments for the issue comments other users post")
+
+	webhookNamespace := "default"
+	flag.StringVar(&webhookNamespace, "webhook-namespace", webhookNamespace, "namespace the observed Comments are created in")
+
+	chatOpsConfig := ""
+	flag.StringVar(&chatOpsConfig, "chatops-config", chatOpsConfig, "file mapping the Github users and teams that may run slash commands to Kubernetes identities. Commands are ignored if empty")
+
+	importIssues := ""
+	flag.StringVar(&importIssues, "import-issues", importIssues, "comma separated Github issues 'owner/repo#number' whose comments are imported into observed Comments by polling")
+
+	importInterval := time.Minute
+	flag.DurationVar(&importInterval, "import-interval", importInterval, "how often the issues of --import-issues are polled")
+
+	importNamespace := "default"
+	flag.StringVar(&importNamespace, "import-namespace", importNamespace, "namespace the imported Comments are created in")
+
+	previewRepositories := ""
+	flag.StringVar(&previewRepositories, "preview-repositories", previewRepositories, "comma separated Github repositories 'owner/repo' whose labelled pull requests get preview environments")
+
+	previewLabel := "preview"
+	flag.StringVar(&previewLabel, "preview-label", previewLabel, "label that requests a preview environment for a pull request")
+
+	previewManifests := ""
+	flag.StringVar(&previewManifests, "preview-manifests", previewManifests, "directory of the manifest templates preview environments are created from")
+
+	previewInterval := time.Minute
+	flag.DurationVar(&previewInterval, "preview-interval", previewInterval, "how often the pull requests of --preview-repositories are checked")
+
+	configFile := ""
+	flag.StringVar(&configFile, "config", configFile, "configuration file of the controller. Its settings are reloaded when it changes, except for resyncPeriod and workers")
+
+	namespaces := ""
+	flag.StringVar(&namespaces, "namespaces", namespaces, "comma separated namespaces to watch, so that the controller only needs a Role in each of them (default all). Overrides namespaces of --config")
+
+	labelSelector := ""
+	flag.StringVar(&labelSelector, "label-selector", labelSelector, "only watch the objects of our resources matching this label selector, like 'tenant=a'. Overrides labelSelector of --config")
+
+	fieldSelector := ""
+	flag.StringVar(&fieldSelector, "field-selector", fieldSelector, "only watch the objects of our resources matching this field selector. Overrides fieldSelector of --config")
+
+	workers := 1
+	flag.IntVar(&workers, "workers", workers, "number of workers processing each queue concurrently. Overrides workers of --config")
+
+	shutdownGracePeriod := time.Second * 20
+	flag.DurationVar(&shutdownGracePeriod, "shutdown-grace-period", shutdownGracePeriod, "how long the workers are given to finish on SIGTERM. Keep it below the terminationGracePeriodSeconds of the pod")
+
+	leaderElect := false
+	flag.BoolVar(&leaderElect, "leader-elect", leaderElect, "elect a leader among the replicas of the controller, only the leader runs the workers")
+
+	leaderElectNamespace := ""
+	flag.StringVar(&leaderElectNamespace, "leader-elect-namespace", leaderElectNamespace, "namespace of the Lease the leader holds (default $POD_NAMESPACE, or 'default')")
+
+	leaderElectName := "kube-custom-controller"
+	flag.StringVar(&leaderElectName, "leader-elect-name", leaderElectName, "name of the Lease the leader holds")
+
+	leaderElectLeaseDuration := time.Second * 15
+	flag.DurationVar(&leaderElectLeaseDuration, "leader-elect-lease-duration", leaderElectLeaseDuration, "how long the other replicas wait before taking over the lease of a leader that stopped renewing it")
+
+	leaderElectRenewDeadline := time.Second * 10
+	flag.DurationVar(&leaderElectRenewDeadline, "leader-elect-renew-deadline", leaderElectRenewDeadline, "how long the leader keeps retrying to renew its lease before it gives up leadership")
+
+	leaderElectRetryPeriod := time.Second * 2
+	flag.DurationVar(&leaderElectRetryPeriod, "leader-elect-retry-period", leaderElectRetryPeriod, "how long replicas wait between attempts to acquire or renew the lease")
+
+	workerStuckTimeout := time.Minute * 10
+	flag.DurationVar(&workerStuckTimeout, "worker-stuck-timeout", workerStuckTimeout, "how long a worker may process a single object before /healthz reports the controller as stuck")
+
+	logFormat := "text"
+	flag.StringVar(&logFormat, "log-format", logFormat, "format of the logs, 'text' or 'json'. --v sets the verbosity of both")
+
+	// adds --v and the other klog flags.
+	klog.InitFlags(nil)
+
 	flag.Parse()
+	defer klog.Flush()
+
+	if err := setupLogging(logFormat); err != nil {
+		fmt.Fprintf(os.Stderr, "error configuring logging: %v", err)
+		os.Exit(1)
+	}
+
+	// the flags given explicitly take precedence over the configuration
+	// file.
+	controllerConfig := defaultConfiguration()
+	if configFile != "" {
+		var err error
+		controllerConfig, err = loadConfiguration(configFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error loading configuration: %v", err)
+			os.Exit(1)
+		}
+	}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "workers":
+			controllerConfig.Workers = workers
+		case "namespaces":
+			controllerConfig.Namespaces = parseNamespaces(namespaces)
+		case "label-selector":
+			controllerConfig.LabelSelector = labelSelector
+		case "field-selector":
+			controllerConfig.FieldSelector = fieldSelector
+		}
+	})
+	if err := controllerConfig.validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid flags: %v", err)
+		os.Exit(1)
+	}
+	workers = controllerConfig.Workers
+	applyConfiguration(controllerConfig)
 
 	// set kubeconfig
 	if kubeconfig == "" {
@@ -67,152 +276,425 @@ func main() {
 	}
 
 	// create the Kubernetes client
+	restConfig = config
 	cl = client.NewForConfigOrDie(config)
+	kubeClient = kubernetes.NewForConfigOrDie(config)
+	dynamicClient = dynamic.NewForConfigOrDie(config)
+
+	// record Events through the core Events API. The scheme of our
+	// clientset knows the kinds of our resources, which the Events refer to.
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+	defer broadcaster.Shutdown()
+	recorder = broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "kube-custom-controller"})
 
 	// set github API token
 	if githubToken == "" {
 		githubToken = os.Getenv("TOKEN")
 	}
 
-	// Create an authenticated Github client.
-	ctx = context.Background()
+	// Create an authenticated Github client. Its requests are cancelled
+	// when the workers did not finish within the shutdown grace period.
+	var cancel context.CancelFunc
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: githubToken},
 	)
 	tc := oauth2.NewClient(ctx, ts)
+	tc.Transport = &githubTransport{base: tc.Transport}
 	githubClient = github.NewClient(tc)
+	githubV4Client = githubv4.NewClient(tc)
+
+	// we use shared informers from the informer factories, to save calls to
+	// the API as we grow our application and so state is consistent between
+	// our control loops. We set a resync period of 30 seconds by default, in
+	// case any create/replace/update/delete operations are missed when
+	// watching
+	if err := setupInformerFactories(controllerConfig.Namespaces, controllerConfig.LabelSelector, controllerConfig.FieldSelector, controllerConfig.ResyncPeriod.Duration); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid flags: %v", err)
+		os.Exit(1)
+	}
+	if !watchesAllNamespaces() {
+		klog.InfoS("Watching namespaces", "namespaces", controllerConfig.Namespaces)
+	}
 
-	// we use a shared informer from the informer factory, to save calls to the
-	// API as we grow our application and so state is consistent between our
-	// control loops. We set a resync period of 30 seconds, in case any
-	// create/replace/update/delete operations are missed when watching
-	sharedFactory = factory.NewSharedInformerFactory(cl, time.Second*30)
-
-	informer := sharedFactory.Github().V1().Comments().Informer()
-	informer.AddEventHandler(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: enqueue,
-			UpdateFunc: func(old, cur interface{}) {
-				if !reflect.DeepEqual(old, cur) {
-					enqueue(cur)
-				}
-			},
-			DeleteFunc: enqueue,
-		},
-	)
+	// the queue of the Comment controller is created in New, after the
+	// metrics provider was set.
+	useWorkqueueMetrics()
+	var comments *commentcontroller.Controller
+	for _, f := range sharedFactories {
+		if comments == nil {
+			comments = commentcontroller.New(cl, f, commentcontroller.NewGitHub(githubClient), recorder, newRetryLimiter())
+		} else {
+			comments.Watch(f)
+		}
+	}
+	comments.Instrument = instrument
 
-	// start the informer.
-	sharedFactory.Start(stopCh)
-	log.Printf("Started informer factory.")
+	// ClusterComments are cluster scoped, watching them needs a ClusterRole.
+	if watchesAllNamespaces() {
+		comments.WatchClusterComments(informersFor(metav1.NamespaceAll))
+	} else {
+		klog.InfoS("Not watching ClusterComments, they are cluster scoped")
+	}
 
-	// wait for the informer cache to finish performing it's initial sync of
-	// resources
-	if !cache.WaitForCacheSync(stopCh, informer.HasSynced) {
-		log.Fatalf("error waiting for informer cache to sync: %s", err.Error())
+	controllers := []controller{
+		{"pullrequests", informersOf("pullrequests"), pullRequestQueue, processPullRequest},
+		{"discussions", informersOf("discussions"), discussionQueue, processDiscussion},
+		{"actionssecrets", informersOf("actionssecrets"), actionsSecretQueue, processActionsSecret},
+		{"workflowtriggers", informersOf("workflowtriggers"), workflowTriggerQueue, processWorkflowTrigger},
+		{"repositoryfiles", informersOf("repositoryfiles"), repositoryFileQueue, processRepositoryFile},
+		{"commentcampaigns", informersOf("commentcampaigns"), commentCampaignQueue, processCommentCampaign},
+		{"notificationrules", informersOf("notificationrules"), notificationRuleQueue, processNotificationRule},
+		{name: "notifications", queue: notificationQueue, process: processNotifications, requeue: requeueNotifications},
 	}
 
-	log.Printf("Finished populating shared informer cache.")
-	// here we start just one worker reading objects off the queue. If you
-	// wanted to parallelize this, you could start many instances of the worker
-	// function, then ensure your application handles concurrency correctly.
-	work()
-}
+	if eventIssue != "" {
+		eventBridge, err = newEventBridge(eventIssue, eventKinds, eventNamespaces, eventReasons, eventWindow)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error configuring event bridge: %v", err)
+			os.Exit(1)
+		}
+		klog.InfoS("Forwarding Warning events", "config", eventBridge.String())
+		controllers = append(controllers, controller{"events", kubeInformersOf("events"), eventQueue, processEvent})
+	}
 
-// sync will attempt to 'Sync' a resource. It checks to see if the comment
-// has already been created, and if not will send it and update the resource
-// accordingly. This method is called whenever this controller starts, and
-// whenever the resource changes, and also periodically every resyncPeriod.
-func sync(comment *v1.Comment) error {
-	// If the comment has already been created, we exit with no error
-	if comment.Status.Created {
-		log.Printf("Skipping already Sent alert '%s/%s'", comment.Namespace, comment.Name)
-		return nil
+	if alertmanagerIssue != "" {
+		if alertmanagerToken == "" {
+			alertmanagerToken = os.Getenv("ALERTMANAGER_TOKEN")
+		}
+		if err := checkWatched("alertmanager-namespace", alertmanagerNamespace); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid flags: %v", err)
+			os.Exit(1)
+		}
+		receiver, err := newAlertmanagerReceiver(alertmanagerIssue, alertmanagerNamespace, alertmanagerToken, alertmanagerRepositories)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error configuring Alertmanager receiver: %v", err)
+			os.Exit(1)
+		}
+		httpMux.Handle("/alertmanager", receiver)
 	}
 
-	// send the comment now
-	if err := sendComment(ctx, githubClient, comment.Spec.Message); err != nil {
-		return err
+	if chatOpsConfig != "" {
+		chatOps, err = loadChatOpsConfig(chatOpsConfig)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error loading ChatOps configuration: %v", err)
+			os.Exit(1)
+		}
+		controllers = append(controllers, controller{name: "chatops", queue: chatOpsQueue, process: processChatOpsCommand, requeue: requeueChatOpsCommands})
 	}
 
-	log.Printf("Sent github comment!")
-	log.Printf(comment.Spec.Message)
+	// index Comments by the ID of their comment on Github, so that changes
+	// to it can be matched to them.
+	for _, informer := range informersOf("comments") {
+		if err := informer.AddIndexers(cache.Indexers{commentIDIndex: commentIDIndexFunc}); err != nil {
+			klog.ErrorS(err, "Error indexing Comments")
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+	}
 
-	// mark it as created
-	comment.Status.Created = true
-	if _, err := cl.GithubV1().Comments(comment.Namespace).Update(comment); err != nil {
-		return fmt.Errorf("error saving update to Comment resource: %s", err.Error())
+	if webhookSecret == "" {
+		webhookSecret = os.Getenv("WEBHOOK_SECRET")
+	}
+	if webhookSecret != "" {
+		if webhookMirror {
+			if err := checkWatched("webhook-namespace", webhookNamespace); err != nil {
+				fmt.Fprintf(os.Stderr, "invalid flags: %v", err)
+				os.Exit(1)
+			}
+		}
+		webhook, err := newGithubWebhook(webhookSecret, webhookMirror, webhookNamespace)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error configuring Github webhook receiver: %v", err)
+			os.Exit(1)
+		}
+		httpMux.Handle("/github", webhook)
 	}
-	log.Printf("Finished saving update to Comment resource '%s/%s'", comment.Namespace, comment.Name)
 
-	// we didn't encounter any errors, so we return nil to allow the callee
-	// to 'forget' this item from the queue altogether.
-	return nil
-}
+	var importer *commentImporter
+	if importIssues != "" {
+		if err := checkWatched("import-namespace", importNamespace); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid flags: %v", err)
+			os.Exit(1)
+		}
+		importer, err = newCommentImporter(importIssues, importNamespace, importInterval)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error configuring comment importer: %v", err)
+			os.Exit(1)
+		}
+	}
 
-func work() {
-	for {
-		// we read a message off the queue
-		key, shutdown := queue.Get()
+	if previewRepositories != "" {
+		// the preview Comments are created in namespaces of their own.
+		if !watchesAllNamespaces() {
+			fmt.Fprintf(os.Stderr, "invalid flags: --preview-repositories needs every namespace to be watched, not only --namespaces")
+			os.Exit(1)
+		}
+		previews, err = newPreviews(previewRepositories, previewLabel, previewManifests, previewInterval)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error configuring preview environments: %v", err)
+			os.Exit(1)
+		}
+		controllers = append(controllers, controller{name: "previews", queue: previewQueue, process: processPreviews, requeue: previews.requeue})
+	}
 
-		// if the queue has been shut down, we should exit the work queue here
-		if shutdown {
-			stopCh <- struct{}{}
-			return
+	synced := []cache.InformerSynced{comments.HasSynced}
+	if crashLoopRepository != "" {
+		crashLoops, err = newCrashLoops(crashLoopRepository, crashLoopHealthyPeriod, crashLoopLogLines)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error configuring crash loop issues: %v", err)
+			os.Exit(1)
 		}
+		controllers = append(controllers, controller{name: "crashloops", queue: crashLoopQueue, process: processCrashLoop, requeue: requeueCrashLoops})
+		synced = append(synced, watchCrashLoops()...)
+	}
 
-		// convert the queue item into a string. If it's not a string, we'll
-		// simply discard it as invalid data and log a message.
-		var strKey string
-		var ok bool
-		if strKey, ok = key.(string); !ok {
-			runtime.HandleError(fmt.Errorf("key in queue should be of type string but got %T. discarding", key))
-			return
+	requeueOnEnable[commentcontroller.Name] = comments.Requeue
+	for _, c := range controllers {
+		for _, informer := range c.informers {
+			informer.AddEventHandler(eventHandler(c.queue))
+			synced = append(synced, informer.HasSynced)
+		}
+		requeueOnEnable[c.name] = c.requeueAll
+	}
+	synced = append(synced, watchActionsSecretSources()...)
+	synced = append(synced, watchRepositoryFileSources()...)
+
+	// shut down on SIGINT and SIGTERM, and exit right away on the second
+	// signal.
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		klog.InfoS("Shutting down", "signal", sig.String())
+		close(stopCh)
+		sig = <-signals
+		klog.InfoS("Exiting on second signal", "signal", sig.String())
+		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+	}()
+
+	registerMetrics()
+	probes.synced = synced
+	probes.stuckTimeout = workerStuckTimeout
+	probes.register(httpMux)
+
+	// without leader election we are the only replica, and lead right away.
+	var (
+		leading <-chan struct{}
+		release = func() {}
+	)
+	if leaderElect {
+		if leaderElectNamespace == "" {
+			leaderElectNamespace = os.Getenv("POD_NAMESPACE")
+		}
+		if leaderElectNamespace == "" {
+			leaderElectNamespace = "default"
 		}
+		leading, release, err = leaderElection(leaderElectNamespace, leaderElectName, leaderElectLeaseDuration, leaderElectRenewDeadline, leaderElectRetryPeriod)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error configuring leader election: %v", err)
+			os.Exit(1)
+		}
+	} else {
+		elected := make(chan struct{})
+		close(elected)
+		leading = elected
+		leader.Set(1)
+	}
 
-		// we define a function here to process a queue item, so that we can
-		// use 'defer' to make sure the message is marked as Done on the queue
-		func(key string) {
-			defer queue.Done(key)
+	// start the informers.
+	startInformers(stopCh)
+	klog.InfoS("Started informer factories")
 
-			// attempt to split the 'key' into namespace and object name
-			namespace, name, err := cache.SplitMetaNamespaceKey(strKey)
+	if configFile != "" {
+		go watchConfiguration(configFile, stopCh)
+	}
 
-			if err != nil {
-				runtime.HandleError(fmt.Errorf("error splitting meta namespace key into parts: %s", err.Error()))
-				return
+	// serve the probes while the caches sync, the other endpoints are only
+	// served once they did.
+	var server *http.Server
+	if listenAddress != "" {
+		server = &http.Server{Addr: listenAddress, Handler: probes.gate(httpMux)}
+		go func() {
+			var err error
+			if tlsCertFile != "" {
+				err = server.ListenAndServeTLS(tlsCertFile, tlsKeyFile)
+			} else {
+				err = server.ListenAndServe()
 			}
+			if err != http.ErrServerClosed {
+				klog.ErrorS(err, "Error serving HTTP endpoints", "address", listenAddress)
+				klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+			}
+		}()
+		klog.InfoS("Serving HTTP endpoints", "address", listenAddress)
+		go probes.run(stopCh)
+	}
 
-			log.Printf("Read item '%s/%s' off workqueue. Processing...", namespace, name)
+	// wait for the informer caches to finish performing their initial sync
+	// of resources
+	if !cache.WaitForCacheSync(stopCh, synced...) {
+		select {
+		case <-stopCh:
+			// shut down before the caches were synced, nothing ran yet.
+			release()
+			return
+		default:
+		}
+		klog.ErrorS(nil, "Error waiting for informer caches to sync")
+		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+	}
 
-			// retrieve the latest version in the cache of this comment
-			obj, err := sharedFactory.Github().V1().Comments().Lister().Comments(namespace).Get(name)
-			if err != nil {
-				runtime.HandleError(fmt.Errorf("error getting object '%s/%s' from api: %s", namespace, name, err.Error()))
-				return
+	klog.InfoS("Finished populating shared informer caches")
+
+	// all replicas keep their caches warm, but only the leader runs the
+	// workers.
+	var running sync.WaitGroup
+	runCtx, stopRun := context.WithCancel(context.Background())
+	defer stopRun()
+	select {
+	case <-leading:
+		if importer != nil {
+			go importer.run(stopCh)
+		}
+		if previews != nil {
+			go previews.run(stopCh)
+		}
+
+		running.Add(1)
+		go func() {
+			defer running.Done()
+			if err := comments.Run(runCtx, workers); err != nil {
+				klog.ErrorS(err, "Error running the Comment controller")
+			}
+		}()
+
+		// start the workers of every queue. The queue never hands the same
+		// key to two workers at once, so an object is only ever synced by
+		// one of them at a time.
+		for _, c := range controllers {
+			for i := 0; i < workers; i++ {
+				running.Add(1)
+				go func(c controller) {
+					defer running.Done()
+					commentcontroller.Work(c.name, c.queue, c.process, instrument)
+				}(c)
 			}
+		}
+		klog.InfoS("Started workers", "workersPerQueue", workers)
+	case <-stopCh:
+	}
+
+	// block until we are asked to shut down
+	<-stopCh
+	stopRun()
+
+	finished := shutdown(controllers, server, &running, shutdownGracePeriod)
+	// only give up the lease once the workers stopped, so that the next
+	// leader does not work next to them.
+	release()
+	if !finished {
+		cancel()
+		os.Exit(1)
+	}
+	klog.InfoS("Shut down cleanly")
+}
+
+// shutdown stops accepting work and waits up to 'gracePeriod' for the
+// workers to finish the objects they are processing. The informers were
+// stopped already by closing stopCh. It returns false if the workers did not
+// finish in time.
+func shutdown(controllers []controller, server *http.Server, running *sync.WaitGroup, gracePeriod time.Duration) bool {
+	deadline, cancel := context.WithTimeout(context.Background(), gracePeriod)
+	defer cancel()
+
+	// the queues hand out the keys that are waiting already, and then report
+	// that they were shut down, which ends the workers.
+	for _, c := range controllers {
+		c.queue.ShutDown()
+	}
+	if server != nil {
+		if err := server.Shutdown(deadline); err != nil {
+			klog.ErrorS(err, "Error shutting down HTTP endpoints")
+		}
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		running.Wait()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+		return true
+	case <-deadline.Done():
+		klog.InfoS("Workers did not finish within the grace period, exiting", "gracePeriod", gracePeriod)
+		return false
+	}
+}
 
-			log.Printf("Got most up to date version of '%s/%s'. Syncing...", namespace, name)
+// requeueAll adds every object of the controller into its queue.
+func (c controller) requeueAll() {
+	if c.requeue != nil {
+		c.requeue()
+	}
+	for _, informer := range c.informers {
+		for _, obj := range informer.GetStore().List() {
+			enqueue(c.queue, obj)
+		}
+	}
+}
 
-			// attempt to sync the current state of the world with the desired!
-			// If sync returns an error, we skip calling `queue.Forget`,
-			// thus causing the resource to be requeued at a later time.
-			if err := sync(obj); err != nil {
-				runtime.HandleError(fmt.Errorf("error processing item '%s/%s': %s", namespace, name, err.Error()))
-				return
+// eventHandler returns the informer event handlers that add changed objects
+// into 'queue'.
+func eventHandler(queue workqueue.Interface) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			enqueue(queue, obj)
+		},
+		UpdateFunc: func(old, cur interface{}) {
+			if !reflect.DeepEqual(old, cur) {
+				enqueue(queue, cur)
 			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			enqueue(queue, obj)
+		},
+	}
+}
 
-			log.Printf("Finished processing '%s/%s' successfully! Removing from queue.", namespace, name)
+// sourceHandler returns informer event handlers that call 'changed' with the
+// namespace and name of every object that is added, changed or deleted. It is
+// used to watch the objects other resources read from, like Secrets and
+// ConfigMaps.
+func sourceHandler(changed func(namespace, name string)) cache.ResourceEventHandlerFuncs {
+	notify := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		object, err := meta.Accessor(obj)
+		if err != nil {
+			runtime.HandleError(fmt.Errorf("error reading metadata of %T: %w", obj, err))
+			return
+		}
+		changed(object.GetNamespace(), object.GetName())
+	}
 
-			// as we managed to process this successfully, we can forget it
-			// from the work queue altogether.
-			queue.Forget(key)
-		}(strKey)
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: notify,
+		UpdateFunc: func(old, cur interface{}) {
+			if !reflect.DeepEqual(old, cur) {
+				notify(cur)
+			}
+		},
+		DeleteFunc: notify,
 	}
 }
 
 // enqueue will add an object 'obj' into the workqueue. The object being added
 // must be of type metav1.Object, metav1.ObjectAccessor or cache.ExplicitKey.
-func enqueue(obj interface{}) {
+func enqueue(queue workqueue.Interface, obj interface{}) {
 	// DeletionHandlingMetaNamespaceKeyFunc will convert an object into a
 	// 'namespace/name' string. We do this because our item may be processed
 	// much later than now, and so we want to ensure it gets a fresh copy of
@@ -220,20 +702,21 @@ func enqueue(obj interface{}) {
 	// same item into the work queue without duplicates building up.
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
-		runtime.HandleError(fmt.Errorf("error obtaining key for object being enqueue: %s", err.Error()))
+		runtime.HandleError(fmt.Errorf("error obtaining key for object being enqueue: %w", err))
 		return
 	}
 	// add the item to the queue
 	queue.Add(key)
 }
 
-func sendComment(ctx context.Context, client *github.Client, message string) error {
+// sendComment posts 'message' as a comment on issue 'number' of 'owner/repo'.
+func sendComment(ctx context.Context, client *github.Client, owner, repo string, number int, message string) (*github.IssueComment, error) {
 	comment := &github.IssueComment{
 		Body: &message,
 	}
-	_, _, err := client.Issues.CreateComment(ctx, "nikhita", "kube-custom-controller", 2, comment)
+	created, _, err := client.Issues.CreateComment(ctx, owner, repo, number, comment)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return created, nil
 }
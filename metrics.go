@@ -0,0 +1,271 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+// leader is 1 while this replica runs the workers.
+var leader = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "kube_custom_controller_leader",
+	Help: "Whether this replica is the leader and runs the workers.",
+})
+
+var (
+	reconciles = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kube_custom_controller_reconcile_total",
+		Help: "Number of objects synced, by controller and result.",
+	}, []string{"controller", "result"})
+
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "kube_custom_controller_reconcile_duration_seconds",
+		Help:    "How long syncing an object took, by controller and result.",
+		Buckets: prometheus.ExponentialBuckets(0.01, 2, 12),
+	}, []string{"controller", "result"})
+)
+
+var (
+	githubRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kube_custom_controller_github_requests_total",
+		Help: "Number of requests made to the Github API, by endpoint and status code.",
+	}, []string{"endpoint", "code"})
+
+	githubRateLimitRemaining = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kube_custom_controller_github_rate_limit_remaining",
+		Help: "Number of requests left in the current Github API rate limit window, by resource.",
+	}, []string{"resource"})
+
+	githubRateLimitReset = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kube_custom_controller_github_rate_limit_reset_timestamp_seconds",
+		Help: "When the current Github API rate limit window resets, in seconds since the epoch, by resource.",
+	}, []string{"resource"})
+)
+
+// the metrics of the workqueues, named like the ones of the Kubernetes
+// controllers so that the same dashboards work for them.
+var (
+	workqueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "workqueue_depth",
+		Help: "Current depth of the workqueue.",
+	}, []string{"name"})
+
+	workqueueAdds = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "workqueue_adds_total",
+		Help: "Total number of adds handled by the workqueue.",
+	}, []string{"name"})
+
+	workqueueLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "workqueue_queue_duration_seconds",
+		Help:    "How long an item stays in the workqueue before being requested.",
+		Buckets: prometheus.ExponentialBuckets(10e-9, 10, 10),
+	}, []string{"name"})
+
+	workqueueWorkDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "workqueue_work_duration_seconds",
+		Help:    "How long processing an item from the workqueue takes.",
+		Buckets: prometheus.ExponentialBuckets(10e-9, 10, 10),
+	}, []string{"name"})
+
+	workqueueUnfinishedWork = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "workqueue_unfinished_work_seconds",
+		Help: "How many seconds of work are in progress and not yet observed by workqueue_work_duration_seconds.",
+	}, []string{"name"})
+
+	workqueueLongestRunningProcessor = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "workqueue_longest_running_processor_seconds",
+		Help: "How many seconds the longest running processor of the workqueue has been running.",
+	}, []string{"name"})
+
+	workqueueRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "workqueue_retries_total",
+		Help: "Total number of retries handled by the workqueue.",
+	}, []string{"name"})
+)
+
+// registerMetrics registers the metrics of the controller and serves them
+// on /metrics.
+func registerMetrics() {
+	prometheus.MustRegister(
+		leader,
+		reconciles,
+		reconcileDuration,
+		githubRequests,
+		githubRateLimitRemaining,
+		githubRateLimitReset,
+		workqueueDepth,
+		workqueueAdds,
+		workqueueLatency,
+		workqueueWorkDuration,
+		workqueueUnfinishedWork,
+		workqueueLongestRunningProcessor,
+		workqueueRetries,
+	)
+	httpMux.Handle("/metrics", promhttp.Handler())
+}
+
+// observeReconcile records that the controller 'name' synced an object,
+// which took since 'start' and failed with 'err' if not nil.
+func observeReconcile(name string, start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	reconciles.WithLabelValues(name, result).Inc()
+	reconcileDuration.WithLabelValues(name, result).Observe(time.Since(start).Seconds())
+}
+
+// instrument records the syncs of the controller 'name' in the metrics, and
+// tracks them for /healthz. The syncs of disabled controllers are skipped,
+// their objects are requeued when they are enabled again, see
+// requeueOnEnable.
+func instrument(name string, sync func() error) error {
+	if !currentConfiguration().enabled(name) {
+		klog.V(4).InfoS("Skipping sync of disabled controller", "controller", name)
+		return nil
+	}
+
+	done := probes.started(name)
+	defer done()
+
+	start := time.Now()
+	err := sync()
+	observeReconcile(name, start, err)
+	return err
+}
+
+// workqueueMetricsProvider hands the workqueues the metrics above. It has to
+// be set before the first queue is created, see useWorkqueueMetrics.
+type workqueueMetricsProvider struct{}
+
+var setWorkqueueMetricsProvider sync.Once
+
+// useWorkqueueMetrics makes the queues created from now on export the
+// metrics above.
+func useWorkqueueMetrics() {
+	setWorkqueueMetricsProvider.Do(func() {
+		workqueue.SetProvider(workqueueMetricsProvider{})
+	})
+}
+
+func (workqueueMetricsProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	return workqueueDepth.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewAddsMetric(name string) workqueue.CounterMetric {
+	return workqueueAdds.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewLatencyMetric(name string) workqueue.HistogramMetric {
+	return workqueueLatency.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewWorkDurationMetric(name string) workqueue.HistogramMetric {
+	return workqueueWorkDuration.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewUnfinishedWorkSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return workqueueUnfinishedWork.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewLongestRunningProcessorSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return workqueueLongestRunningProcessor.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return workqueueRetries.WithLabelValues(name)
+}
+
+// githubTransport counts the requests made to the Github API, and records
+// the rate limit reported in their responses. Successful requests make the
+// controller ready, see health. Requests wait for githubLimiter first.
+type githubTransport struct {
+	base http.RoundTripper
+}
+
+func (t *githubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := githubLimiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	endpoint := req.Method + " " + githubEndpoint(req.URL.Path)
+	if err != nil {
+		githubRequests.WithLabelValues(endpoint, "error").Inc()
+		klog.V(4).InfoS("Github API request failed", "endpoint", endpoint, "duration", time.Since(start), "err", err.Error())
+		return resp, err
+	}
+	githubRequests.WithLabelValues(endpoint, strconv.Itoa(resp.StatusCode)).Inc()
+	klog.V(4).InfoS("Github API request", "endpoint", endpoint, "code", resp.StatusCode, "duration", time.Since(start), "githubRequestID", resp.Header.Get("X-GitHub-Request-Id"))
+	if resp.StatusCode < 400 {
+		probes.githubSucceeded()
+	}
+
+	resource := resp.Header.Get("X-RateLimit-Resource")
+	if resource == "" {
+		resource = "core"
+	}
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+		githubRateLimitRemaining.WithLabelValues(resource).Set(float64(remaining))
+	}
+	if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		githubRateLimitReset.WithLabelValues(resource).Set(float64(reset))
+	}
+	return resp, nil
+}
+
+// githubEndpoint returns 'path' with the names and numbers in it replaced
+// by placeholders, like '/repos/:owner/:repo/issues/:id/comments', so that
+// the requests to an endpoint are counted together.
+func githubEndpoint(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i < len(segments); i++ {
+		if _, err := strconv.ParseInt(segments[i], 10, 64); err == nil {
+			segments[i] = ":id"
+			continue
+		}
+		next := func(placeholder string) {
+			if i+1 < len(segments) {
+				segments[i+1] = placeholder
+				i++
+			}
+		}
+		rest := func(placeholder string) {
+			if i+1 < len(segments) {
+				segments = append(segments[:i+1], placeholder)
+				i++
+			}
+		}
+		switch segments[i] {
+		case "repos":
+			next(":owner")
+			next(":repo")
+		case "orgs":
+			next(":org")
+		case "users", "memberships":
+			next(":user")
+		case "teams":
+			next(":team")
+		case "environments":
+			next(":environment")
+		case "secrets", "variables":
+			next(":name")
+		case "workflows":
+			next(":workflow")
+		case "contents":
+			// file paths and refs span any number of segments.
+			rest(":path")
+		case "ref", "refs":
+			rest(":ref")
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
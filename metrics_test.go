@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commentcontroller "github.com/nikhita/kube-custom-controller/pkg/controller"
+)
+
+func TestFailedSyncIsRetried(t *testing.T) {
+	c := defaultConfiguration()
+	c.Retry.BaseDelay = metav1.Duration{Duration: time.Millisecond}
+	c.Retry.MaxDelay = metav1.Duration{Duration: time.Millisecond}
+	applyConfiguration(c)
+	defer applyConfiguration(defaultConfiguration())
+
+	const name = "test-retries"
+	queue := newQueue(name)
+	retries := testutil.ToFloat64(workqueueRetries.WithLabelValues(name))
+
+	// the first sync fails, the retry succeeds.
+	synced := make(chan error, 2)
+	attempts := 0
+	process := func(namespace, name string) error {
+		var err error
+		if attempts++; attempts == 1 {
+			err = fmt.Errorf("sync failed")
+		}
+		synced <- err
+		return err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		commentcontroller.Work(name, queue, process, nil)
+	}()
+	queue.Add("default/comment")
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-synced:
+		case <-time.After(time.Second * 10):
+			t.Fatalf("expected %d syncs, got %d", 2, i)
+		}
+	}
+	queue.ShutDown()
+	<-done
+
+	if got := testutil.ToFloat64(workqueueRetries.WithLabelValues(name)) - retries; got != 1 {
+		t.Errorf("expected 1 retry, got %v", got)
+	}
+	if got := queue.NumRequeues("default/comment"); got != 0 {
+		t.Errorf("expected the key to be forgotten after the successful retry, got %d requeues", got)
+	}
+}
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sync"
+	"text/template"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/jsonpath"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+)
+
+// maxPendingNotifications is the number of notifications of a rule that
+// wait to be posted, beyond which the oldest ones are dropped.
+const maxPendingNotifications = 100
+
+var (
+	notificationRuleQueue = newQueue("notificationrules")
+
+	// notificationQueue holds the 'namespace/name' of the rules that have
+	// notifications waiting to be posted, which are kept by their watcher.
+	notificationQueue = newQueue("notifications")
+)
+
+// ruleWatchers holds the informer started for every NotificationRule, by
+// 'namespace/name'. The generated informer factories only know about our
+// own types, so every rule gets a dynamic informer of its own that is
+// stopped when the rule changes or goes away.
+var ruleWatchers = struct {
+	sync.Mutex
+	m map[string]*ruleWatcher
+}{m: map[string]*ruleWatcher{}}
+
+// ruleWatcher fires a NotificationRule for the changes its informer sees.
+// The informer handlers only queue the notifications, they are posted by
+// the workers of notificationQueue.
+type ruleWatcher struct {
+	namespace string
+	name      string
+	spec      v1.NotificationRuleSpec
+	events    map[string]bool
+	message   *template.Template
+	condition *jsonpath.JSONPath
+
+	// started is when the informer was started. Objects created before are
+	// listed as added by the informer, but must not fire the rule.
+	started time.Time
+	stop    chan struct{}
+
+	lock    sync.Mutex
+	pending []notification
+}
+
+// notification is what the message of a NotificationRule is rendered with.
+type notification struct {
+	Event        string
+	Object       map[string]interface{}
+	OldObject    map[string]interface{}
+	Condition    string
+	OldCondition string
+}
+
+// processNotificationRule retrieves the latest version of the
+// NotificationRule 'namespace/name' from the cache and syncs it.
+func processNotificationRule(namespace, name string) error {
+	obj, err := informersFor(namespace).Github().V1().NotificationRules().Lister().NotificationRules(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		stopRuleWatcher(namespace + "/" + name)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting object '%s/%s' from api: %w", namespace, name, err)
+	}
+
+	return syncNotificationRule(obj.DeepCopy())
+}
+
+// syncNotificationRule (re)starts the informer of 'rule' unless one is
+// running for its current spec already.
+func syncNotificationRule(rule *v1.NotificationRule) error {
+	key := rule.Namespace + "/" + rule.Name
+
+	ruleWatchers.Lock()
+	watcher, ok := ruleWatchers.m[key]
+	ruleWatchers.Unlock()
+	if ok && reflect.DeepEqual(watcher.spec, rule.Spec) {
+		return nil
+	}
+	stopRuleWatcher(key)
+
+	watcher, err := startRuleWatcher(rule)
+	if err != nil {
+		if updateErr := updateNotificationRuleStatus(rule.Namespace, rule.Name, func(status *v1.NotificationRuleStatus) {
+			status.Error = err.Error()
+		}); updateErr != nil {
+			klog.ErrorS(updateErr, "Error saving update to NotificationRule resource", "notificationRule", klog.KObj(rule))
+		}
+		return fmt.Errorf("error watching %s for '%s': %w", rule.Spec.Resource, key, err)
+	}
+
+	ruleWatchers.Lock()
+	ruleWatchers.m[key] = watcher
+	ruleWatchers.Unlock()
+	klog.InfoS("Watching resource for NotificationRule", "notificationRule", key, "resource", rule.Spec.Resource)
+
+	return updateNotificationRuleStatus(rule.Namespace, rule.Name, func(status *v1.NotificationRuleStatus) {
+		status.Error = ""
+	})
+}
+
+// stopRuleWatcher stops the informer of the NotificationRule 'key', if any.
+func stopRuleWatcher(key string) {
+	ruleWatchers.Lock()
+	defer ruleWatchers.Unlock()
+	if watcher, ok := ruleWatchers.m[key]; ok {
+		close(watcher.stop)
+		delete(ruleWatchers.m, key)
+		klog.InfoS("Stopped watching resource for NotificationRule", "notificationRule", key, "resource", watcher.spec.Resource)
+	}
+}
+
+// startRuleWatcher validates the spec of 'rule' and starts a dynamic
+// informer for the objects it selects. Only objects in the namespace of the
+// rule are watched, so that a rule can not post objects its author may not
+// read, and Secrets are never watched.
+func startRuleWatcher(rule *v1.NotificationRule) (*ruleWatcher, error) {
+	spec := rule.Spec
+	if spec.Namespace != "" && spec.Namespace != rule.Namespace {
+		return nil, fmt.Errorf("namespace must be empty or %q, the namespace of the rule, got %q", rule.Namespace, spec.Namespace)
+	}
+	if spec.Group == "" && spec.Resource == "secrets" {
+		return nil, fmt.Errorf("secrets can not be watched")
+	}
+	message, err := template.New("message").Parse(spec.Message)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing message: %w", err)
+	}
+	var condition *jsonpath.JSONPath
+	if spec.Condition != "" {
+		condition = jsonpath.New("condition")
+		condition.AllowMissingKeys(true)
+		if err := condition.Parse(spec.Condition); err != nil {
+			return nil, fmt.Errorf("error parsing condition: %w", err)
+		}
+	}
+	events := map[string]bool{}
+	for _, event := range spec.Events {
+		if event != "create" && event != "update" && event != "delete" {
+			return nil, fmt.Errorf("unknown event %q", event)
+		}
+		events[event] = true
+	}
+
+	gvr := schema.GroupVersionResource{Group: spec.Group, Version: spec.Version, Resource: spec.Resource}
+	tweak := func(options *metav1.ListOptions) {
+		options.LabelSelector = spec.LabelSelector
+		options.FieldSelector = spec.FieldSelector
+	}
+
+	// fail early if the resource does not exist or we may not list it,
+	// instead of having the informer retry forever.
+	options := metav1.ListOptions{Limit: 1}
+	tweak(&options)
+	if _, err := dynamicClient.Resource(gvr).Namespace(rule.Namespace).List(options); err != nil {
+		return nil, err
+	}
+
+	w := &ruleWatcher{
+		namespace: rule.Namespace,
+		name:      rule.Name,
+		spec:      spec,
+		events:    events,
+		message:   message,
+		condition: condition,
+		started:   time.Now().Truncate(time.Second),
+		stop:      make(chan struct{}),
+	}
+	informer := dynamicinformer.NewFilteredDynamicInformer(dynamicClient, gvr, rule.Namespace, 0, cache.Indexers{}, tweak).Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			object, ok := obj.(*unstructured.Unstructured)
+			if !ok || object.GetCreationTimestamp().Time.Before(w.started) {
+				return
+			}
+			if w.events["create"] {
+				w.enqueue(notification{Event: "create", Object: object.Object})
+			}
+		},
+		UpdateFunc: func(old, cur interface{}) {
+			oldObject, ok := old.(*unstructured.Unstructured)
+			if !ok {
+				return
+			}
+			object, ok := cur.(*unstructured.Unstructured)
+			if !ok || object.GetResourceVersion() == oldObject.GetResourceVersion() {
+				return
+			}
+			w.update(oldObject, object)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			object, ok := obj.(*unstructured.Unstructured)
+			if ok && w.events["delete"] {
+				w.enqueue(notification{Event: "delete", Object: object.Object})
+			}
+		},
+	})
+	go informer.Run(w.stop)
+	return w, nil
+}
+
+// update fires the rule for an update if it fires on every update, or if
+// the value of its condition changed.
+func (w *ruleWatcher) update(old, cur *unstructured.Unstructured) {
+	n := notification{Event: "update", Object: cur.Object, OldObject: old.Object}
+	if w.condition != nil {
+		var err error
+		if n.OldCondition, err = w.evaluate(old); err == nil {
+			n.Condition, err = w.evaluate(cur)
+		}
+		if err != nil {
+			runtime.HandleError(fmt.Errorf("error evaluating condition of '%s/%s': %w", w.namespace, w.name, err))
+			return
+		}
+		if n.Condition != n.OldCondition {
+			w.enqueue(n)
+			return
+		}
+	}
+	if w.events["update"] {
+		w.enqueue(n)
+	}
+}
+
+// evaluate returns the value of the condition for 'object'.
+func (w *ruleWatcher) evaluate(object *unstructured.Unstructured) (string, error) {
+	var buf bytes.Buffer
+	if err := w.condition.Execute(&buf, object.Object); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// enqueue queues 'n' to be posted by a worker.
+func (w *ruleWatcher) enqueue(n notification) {
+	w.lock.Lock()
+	w.pending = append(w.pending, n)
+	if dropped := len(w.pending) - maxPendingNotifications; dropped > 0 {
+		w.pending = w.pending[dropped:]
+		klog.InfoS("Dropping notifications that were not posted yet", "notificationRule", klog.KRef(w.namespace, w.name), "dropped", dropped)
+	}
+	w.lock.Unlock()
+	notificationQueue.Add(w.namespace + "/" + w.name)
+}
+
+// requeueNotifications enqueues every rule with notifications waiting to be
+// posted.
+func requeueNotifications() {
+	ruleWatchers.Lock()
+	defer ruleWatchers.Unlock()
+	for key, w := range ruleWatchers.m {
+		w.lock.Lock()
+		if len(w.pending) > 0 {
+			notificationQueue.Add(key)
+		}
+		w.lock.Unlock()
+	}
+}
+
+// processNotifications posts the notifications queued for the
+// NotificationRule 'namespace/name' in order. A notification that failed to
+// post stays first in line, and is retried with backoff. Notifications of
+// rules that changed or were deleted since are dropped.
+func processNotifications(namespace, name string) error {
+	ruleWatchers.Lock()
+	w, ok := ruleWatchers.m[namespace+"/"+name]
+	ruleWatchers.Unlock()
+	if !ok {
+		return nil
+	}
+
+	for {
+		w.lock.Lock()
+		if len(w.pending) == 0 {
+			w.lock.Unlock()
+			return nil
+		}
+		n := w.pending[0]
+		w.lock.Unlock()
+
+		if err := w.fire(n); err != nil {
+			return err
+		}
+
+		w.lock.Lock()
+		w.pending = w.pending[1:]
+		w.lock.Unlock()
+	}
+}
+
+// fire posts the message of the rule for 'n' and records it in status. It
+// returns the error of posting it, messages that can not be rendered are
+// only recorded, retrying does not help them.
+func (w *ruleWatcher) fire(n notification) error {
+	target := w.spec.Target
+	var body bytes.Buffer
+	err := w.message.Execute(&body, n)
+	var sendErr error
+	if err == nil {
+		_, sendErr = sendComment(ctx, githubClient, target.Owner, target.Repository, target.Number, body.String())
+		err = sendErr
+	}
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("error firing NotificationRule '%s/%s': %w", w.namespace, w.name, err))
+	} else {
+		klog.InfoS("Fired NotificationRule", "notificationRule", klog.KRef(w.namespace, w.name), "event", n.Event)
+	}
+
+	updateErr := updateNotificationRuleStatus(w.namespace, w.name, func(status *v1.NotificationRuleStatus) {
+		if err != nil {
+			status.Error = err.Error()
+			return
+		}
+		status.Fired++
+		status.LastFiredAt = metav1.Now()
+		status.Error = ""
+	})
+	if updateErr != nil {
+		runtime.HandleError(fmt.Errorf("error saving update to NotificationRule resource: %w", updateErr))
+	}
+	return sendErr
+}
+
+// updateNotificationRuleStatus applies 'update' to the status of the latest
+// version of the NotificationRule 'namespace/name'.
+func updateNotificationRuleStatus(namespace, name string, update func(*v1.NotificationRuleStatus)) error {
+	obj, err := informersFor(namespace).Github().V1().NotificationRules().Lister().NotificationRules(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+	rule := obj.DeepCopy()
+	update(&rule.Status)
+	if reflect.DeepEqual(rule.Status, obj.Status) {
+		return nil
+	}
+	_, err = cl.GithubV1().NotificationRules(namespace).Update(rule)
+	return err
+}
@@ -29,8 +29,24 @@ var (
 // Adds the list of known types to api.Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&ActionsSecret{},
+		&ActionsSecretList{},
+		&ClusterComment{},
+		&ClusterCommentList{},
 		&Comment{},
 		&CommentList{},
+		&CommentCampaign{},
+		&CommentCampaignList{},
+		&Discussion{},
+		&DiscussionList{},
+		&NotificationRule{},
+		&NotificationRuleList{},
+		&PullRequest{},
+		&PullRequestList{},
+		&RepositoryFile{},
+		&RepositoryFileList{},
+		&WorkflowTrigger{},
+		&WorkflowTriggerList{},
 	)
 	return nil
 }
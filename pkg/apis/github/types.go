@@ -15,11 +15,22 @@ type Comment struct {
 }
 
 type CommentSpec struct {
-	Message string
+	Message    string
+	Owner      string
+	Repository string
+	Number     int
+	Observed   bool
 }
 
 type CommentStatus struct {
-	Created bool
+	Created       bool
+	CommentID     int64
+	URL           string
+	MessageHash   string
+	Author        string
+	CreatedAt     metav1.Time
+	UpdatedAt     metav1.Time
+	RemoteDeleted bool
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -29,3 +40,311 @@ type CommentList struct {
 	metav1.ObjectMeta
 	Items []Comment
 }
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PullRequest struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec   PullRequestSpec
+	Status PullRequestStatus
+}
+
+type PullRequestSpec struct {
+	Owner      string
+	Repository string
+	Head       string
+	Base       string
+	Title      string
+	Body       string
+	Draft      bool
+	Reviewers  []string
+	Labels     []string
+}
+
+type PullRequestStatus struct {
+	Number         int
+	URL            string
+	State          string
+	MergeableState string
+	ReviewDecision string
+	Merged         bool
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PullRequestList struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Items []PullRequest
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Discussion struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec   DiscussionSpec
+	Status DiscussionStatus
+}
+
+type DiscussionSpec struct {
+	Owner      string
+	Repository string
+	Category   string
+	Title      string
+	Body       string
+	ReplyTo    int
+}
+
+type DiscussionStatus struct {
+	Created          bool
+	DiscussionNodeID string
+	NodeID           string
+	URL              string
+	BodyHash         string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type DiscussionList struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Items []Discussion
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ActionsSecret struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec   ActionsSecretSpec
+	Status ActionsSecretStatus
+}
+
+type ActionsSecretSpec struct {
+	Scope         string
+	Owner         string
+	Repository    string
+	Environment   string
+	Visibility    string
+	SecretName    string
+	SecretKeys    []ActionsSecretKey
+	ConfigMapName string
+	ConfigMapKeys []ActionsSecretKey
+}
+
+type ActionsSecretKey struct {
+	Key  string
+	Name string
+}
+
+type ActionsSecretStatus struct {
+	Secrets   map[string]string
+	Variables map[string]string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ActionsSecretList struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Items []ActionsSecret
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type WorkflowTrigger struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec   WorkflowTriggerSpec
+	Status WorkflowTriggerStatus
+}
+
+type WorkflowTriggerSpec struct {
+	Owner         string
+	Repository    string
+	Workflow      string
+	Ref           string
+	Inputs        map[string]string
+	EventType     string
+	ClientPayload map[string]string
+}
+
+type WorkflowTriggerStatus struct {
+	ObservedGeneration int64
+	Dispatched         bool
+	DispatchedAt       metav1.Time
+	HeadSHA            string
+	RunID              int64
+	RunURL             string
+	RunStatus          string
+	Conclusion         string
+	Conditions         []WorkflowTriggerCondition
+}
+
+type WorkflowTriggerCondition struct {
+	Type               string
+	Status             string
+	LastTransitionTime metav1.Time
+	Reason             string
+	Message            string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type WorkflowTriggerList struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Items []WorkflowTrigger
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type RepositoryFile struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec   RepositoryFileSpec
+	Status RepositoryFileStatus
+}
+
+type RepositoryFileSpec struct {
+	Owner         string
+	Repository    string
+	Path          string
+	Branch        string
+	Content       string
+	ConfigMapName string
+	ConfigMapKey  string
+	CommitMessage string
+	AuthorName    string
+	AuthorEmail   string
+	PullRequest   bool
+	HeadBranch    string
+}
+
+type RepositoryFileStatus struct {
+	BlobSHA           string
+	CommitSHA         string
+	PullRequestNumber int
+	PullRequestURL    string
+	BaseBlobSHA       string
+	DriftedBlobSHA    string
+	DriftDetectedAt   metav1.Time
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type RepositoryFileList struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Items []RepositoryFile
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CommentCampaign struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec   CommentCampaignSpec
+	Status CommentCampaignStatus
+}
+
+type CommentCampaignSpec struct {
+	Query       string
+	Issues      []IssueReference
+	Message     string
+	Concurrency int
+}
+
+type IssueReference struct {
+	Owner      string
+	Repository string
+	Number     int
+}
+
+type CommentCampaignStatus struct {
+	Targets   []CommentCampaignTarget
+	Delivered int
+	Failed    int
+}
+
+type CommentCampaignTarget struct {
+	Owner      string
+	Repository string
+	Number     int
+	Delivered  bool
+	CommentID  int64
+	URL        string
+	Error      string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CommentCampaignList struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Items []CommentCampaign
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterComment struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec   CommentSpec
+	Status CommentStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterCommentList struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Items []ClusterComment
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NotificationRule struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec   NotificationRuleSpec
+	Status NotificationRuleStatus
+}
+
+type NotificationRuleSpec struct {
+	Group         string
+	Version       string
+	Resource      string
+	Namespace     string
+	LabelSelector string
+	FieldSelector string
+	Events        []string
+	Condition     string
+	Message       string
+	Target        IssueReference
+}
+
+type NotificationRuleStatus struct {
+	Fired       int
+	LastFiredAt metav1.Time
+	Error       string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NotificationRuleList struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Items []NotificationRule
+}
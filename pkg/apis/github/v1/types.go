@@ -19,10 +19,36 @@ type Comment struct {
 
 type CommentSpec struct {
 	Message string `json:"message"`
+
+	// Owner, Repository and Number identify the issue the comment is posted
+	// on. They default to issue 2 of nikhita/kube-custom-controller.
+	Owner      string `json:"owner,omitempty"`
+	Repository string `json:"repository,omitempty"`
+	Number     int    `json:"number,omitempty"`
+
+	// Observed marks Comments that mirror a comment someone else posted on
+	// Github. They are never posted by the controller.
+	Observed bool `json:"observed,omitempty"`
 }
 
 type CommentStatus struct {
 	Created bool `json:"delivered"`
+
+	// CommentID and URL identify the comment on Github.
+	CommentID int64  `json:"commentID,omitempty"`
+	URL       string `json:"url,omitempty"`
+	// MessageHash is the hash of the message last posted. The comment is
+	// edited whenever the message changes.
+	MessageHash string `json:"messageHash,omitempty"`
+
+	// Author is the login of the user who posted the comment, CreatedAt and
+	// UpdatedAt when it was posted and last edited. They are only set for
+	// observed Comments.
+	Author    string      `json:"author,omitempty"`
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
+	// RemoteDeleted is set when the comment was deleted on Github.
+	RemoteDeleted bool `json:"remoteDeleted,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -33,3 +59,486 @@ type CommentList struct {
 
 	Items []Comment `json:"items"`
 }
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=pullrequests
+
+// PullRequest is a pull request the controller opens on Github and keeps
+// in sync with its spec.
+type PullRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   PullRequestSpec   `json:"spec"`
+	Status PullRequestStatus `json:"status,omitempty"`
+}
+
+type PullRequestSpec struct {
+	// Owner and Repository identify the repository the pull request is
+	// opened against.
+	Owner      string `json:"owner"`
+	Repository string `json:"repository"`
+
+	// Head is the branch holding the changes, Base the branch they should
+	// be merged into.
+	Head string `json:"head"`
+	Base string `json:"base"`
+
+	Title string `json:"title"`
+	Body  string `json:"body,omitempty"`
+	Draft bool   `json:"draft,omitempty"`
+
+	// Reviewers are the Github logins whose review is requested.
+	Reviewers []string `json:"reviewers,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+}
+
+type PullRequestStatus struct {
+	Number int    `json:"number,omitempty"`
+	URL    string `json:"url,omitempty"`
+	State  string `json:"state,omitempty"`
+
+	// MergeableState is the mergeable_state reported by Github, e.g.
+	// "clean", "blocked" or "dirty".
+	MergeableState string `json:"mergeableState,omitempty"`
+	// ReviewDecision is one of APPROVED, CHANGES_REQUESTED or
+	// REVIEW_REQUIRED, derived from the latest review of each reviewer and
+	// the reviews required by the protection of the base branch. It is
+	// empty when no review is required and nobody reviewed yet.
+	ReviewDecision string `json:"reviewDecision,omitempty"`
+	Merged         bool   `json:"merged,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PullRequestList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Items []PullRequest `json:"items"`
+}
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=discussions
+
+// Discussion is a post to Github Discussions. It either starts a new
+// discussion in a category or, when ReplyTo is set, replies to an existing
+// one. Discussions are only reachable through the GraphQL API.
+type Discussion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   DiscussionSpec   `json:"spec"`
+	Status DiscussionStatus `json:"status,omitempty"`
+}
+
+type DiscussionSpec struct {
+	Owner      string `json:"owner"`
+	Repository string `json:"repository"`
+
+	// Category is the name or slug of the discussion category. It is
+	// ignored for replies.
+	Category string `json:"category,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Body     string `json:"body"`
+
+	// ReplyTo is the number of an existing discussion to reply to.
+	ReplyTo int `json:"replyTo,omitempty"`
+}
+
+type DiscussionStatus struct {
+	Created bool `json:"delivered"`
+
+	// DiscussionNodeID is the GraphQL node ID of the discussion that was
+	// created or replied to, NodeID the one of the posted discussion or
+	// reply.
+	DiscussionNodeID string `json:"discussionNodeID,omitempty"`
+	NodeID           string `json:"nodeID,omitempty"`
+	URL              string `json:"url,omitempty"`
+
+	// BodyHash is the hash of the title and body last posted. The posted
+	// discussion or reply is edited when they change.
+	BodyHash string `json:"bodyHash,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type DiscussionList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Items []Discussion `json:"items"`
+}
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=actionssecrets
+
+// ActionsSecret publishes the keys of a Secret as Github Actions secrets, and
+// the keys of a ConfigMap as Github Actions variables.
+type ActionsSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   ActionsSecretSpec   `json:"spec"`
+	Status ActionsSecretStatus `json:"status,omitempty"`
+}
+
+type ActionsSecretSpec struct {
+	// Scope is one of "repository", "environment" or "organization".
+	// It defaults to "repository".
+	Scope string `json:"scope,omitempty"`
+
+	Owner string `json:"owner"`
+	// Repository is required for the repository and environment scopes.
+	Repository string `json:"repository,omitempty"`
+	// Environment is required for the environment scope.
+	Environment string `json:"environment,omitempty"`
+	// Visibility of organization secrets and variables, one of "all",
+	// "private" or "selected". It defaults to "private".
+	Visibility string `json:"visibility,omitempty"`
+
+	// SecretName names a Secret in the same namespace whose keys are
+	// published as encrypted Actions secrets.
+	SecretName string `json:"secretName,omitempty"`
+	// SecretKeys selects the keys of the Secret to publish. All keys are
+	// published when it is empty.
+	SecretKeys []ActionsSecretKey `json:"secretKeys,omitempty"`
+
+	// ConfigMapName names a ConfigMap in the same namespace whose keys are
+	// published as plain Actions variables.
+	ConfigMapName string `json:"configMapName,omitempty"`
+	// ConfigMapKeys selects the keys of the ConfigMap to publish. All keys
+	// are published when it is empty.
+	ConfigMapKeys []ActionsSecretKey `json:"configMapKeys,omitempty"`
+}
+
+// ActionsSecretKey maps a key of a Secret or ConfigMap to the name it is
+// published under on Github.
+type ActionsSecretKey struct {
+	Key string `json:"key"`
+	// Name defaults to the key, upper-cased and with '-' and '.' replaced
+	// by '_'.
+	Name string `json:"name,omitempty"`
+}
+
+type ActionsSecretStatus struct {
+	// Secrets and Variables map each published name to a hash of the value
+	// last synced to Github.
+	Secrets   map[string]string `json:"secrets,omitempty"`
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ActionsSecretList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Items []ActionsSecret `json:"items"`
+}
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=workflowtriggers
+
+// WorkflowTrigger fires a Github Actions workflow_dispatch or
+// repository_dispatch event once per generation of the object, and follows
+// the workflow run it started.
+type WorkflowTrigger struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   WorkflowTriggerSpec   `json:"spec"`
+	Status WorkflowTriggerStatus `json:"status,omitempty"`
+}
+
+type WorkflowTriggerSpec struct {
+	Owner      string `json:"owner"`
+	Repository string `json:"repository"`
+
+	// Workflow is the file name of the workflow to run, e.g. "deploy.yml".
+	// It selects a workflow_dispatch, together with Ref, a branch or tag,
+	// and Inputs.
+	Workflow string            `json:"workflow,omitempty"`
+	Ref      string            `json:"ref,omitempty"`
+	Inputs   map[string]string `json:"inputs,omitempty"`
+
+	// EventType selects a repository_dispatch instead, sending
+	// ClientPayload along with it. Workflow then only names the workflow
+	// whose run is followed, if several listen to the event.
+	EventType     string            `json:"eventType,omitempty"`
+	ClientPayload map[string]string `json:"clientPayload,omitempty"`
+}
+
+type WorkflowTriggerStatus struct {
+	// ObservedGeneration is the generation the event was fired for. It is
+	// recorded before the event is fired, Dispatched once Github accepted
+	// the event or its workflow run was found.
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+	Dispatched         bool        `json:"dispatched,omitempty"`
+	DispatchedAt       metav1.Time `json:"dispatchedAt,omitempty"`
+
+	// HeadSHA is the commit Ref was resolved to when a workflow_dispatch
+	// was fired. The workflow run is the one on that commit.
+	HeadSHA string `json:"headSHA,omitempty"`
+
+	RunID      int64  `json:"runID,omitempty"`
+	RunURL     string `json:"runURL,omitempty"`
+	RunStatus  string `json:"runStatus,omitempty"`
+	Conclusion string `json:"conclusion,omitempty"`
+
+	// Conditions holds the WorkflowRunFound condition, False if no
+	// workflow run showed up within ten minutes of the event.
+	Conditions []WorkflowTriggerCondition `json:"conditions,omitempty"`
+}
+
+type WorkflowTriggerCondition struct {
+	Type string `json:"type"`
+	// Status is one of "True", "False" or "Unknown".
+	Status             string      `json:"status"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type WorkflowTriggerList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Items []WorkflowTrigger `json:"items"`
+}
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=repositoryfiles
+
+// RepositoryFile keeps a file in a Github repository in sync with a ConfigMap
+// key or with inline content, committing through the Contents API.
+type RepositoryFile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   RepositoryFileSpec   `json:"spec"`
+	Status RepositoryFileStatus `json:"status,omitempty"`
+}
+
+type RepositoryFileSpec struct {
+	Owner      string `json:"owner"`
+	Repository string `json:"repository"`
+	Path       string `json:"path"`
+	// Branch defaults to the default branch of the repository.
+	Branch string `json:"branch,omitempty"`
+
+	// Content is the content of the file. It is ignored when
+	// ConfigMapName is set.
+	Content string `json:"content,omitempty"`
+	// ConfigMapName and ConfigMapKey select a ConfigMap key in the same
+	// namespace holding the content of the file.
+	ConfigMapName string `json:"configMapName,omitempty"`
+	ConfigMapKey  string `json:"configMapKey,omitempty"`
+
+	// CommitMessage is a text/template rendered with the RepositoryFile
+	// as data. It defaults to
+	// "Update {{.Spec.Path}} from {{.Namespace}}/{{.Name}}".
+	CommitMessage string `json:"commitMessage,omitempty"`
+	AuthorName    string `json:"authorName,omitempty"`
+	AuthorEmail   string `json:"authorEmail,omitempty"`
+
+	// PullRequest commits to HeadBranch and opens a pull request into
+	// Branch, instead of committing to Branch directly.
+	PullRequest bool `json:"pullRequest,omitempty"`
+	// HeadBranch defaults to "kube-custom-controller/<namespace>/<name>".
+	HeadBranch string `json:"headBranch,omitempty"`
+}
+
+type RepositoryFileStatus struct {
+	// BlobSHA is the SHA of the file content last committed, CommitSHA the
+	// SHA of that commit.
+	BlobSHA   string `json:"blobSHA,omitempty"`
+	CommitSHA string `json:"commitSHA,omitempty"`
+
+	PullRequestNumber int    `json:"pullRequestNumber,omitempty"`
+	PullRequestURL    string `json:"pullRequestURL,omitempty"`
+	// BaseBlobSHA is the SHA of the file last found on the base branch of
+	// the pull request, in pull request mode.
+	BaseBlobSHA string `json:"baseBlobSHA,omitempty"`
+
+	// DriftedBlobSHA is the SHA of the content last found in place of
+	// BlobSHA, or of BaseBlobSHA on the base branch, committed by someone
+	// else. The file is restored after the drift is recorded, except on the
+	// base branch in pull request mode.
+	DriftedBlobSHA  string      `json:"driftedBlobSHA,omitempty"`
+	DriftDetectedAt metav1.Time `json:"driftDetectedAt,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type RepositoryFileList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Items []RepositoryFile `json:"items"`
+}
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=commentcampaigns
+
+// CommentCampaign posts one comment on each issue matching a search query or
+// listed explicitly, e.g. to announce a deprecation on every open issue with
+// a given label.
+type CommentCampaign struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   CommentCampaignSpec   `json:"spec"`
+	Status CommentCampaignStatus `json:"status,omitempty"`
+}
+
+type CommentCampaignSpec struct {
+	// Query is a Github issue search query, e.g.
+	// "repo:nikhita/kube-custom-controller is:issue is:open label:deprecated".
+	Query string `json:"query,omitempty"`
+	// Issues are commented on in addition to the ones matching Query.
+	Issues []IssueReference `json:"issues,omitempty"`
+
+	// Message is a text/template rendered for every issue, with .Owner,
+	// .Repository, .Number, .Title and .URL set.
+	Message string `json:"message"`
+
+	// Concurrency limits how many comments are posted at once. It defaults
+	// to 4.
+	Concurrency int `json:"concurrency,omitempty"`
+}
+
+// IssueReference identifies an issue or pull request.
+type IssueReference struct {
+	Owner      string `json:"owner"`
+	Repository string `json:"repository"`
+	Number     int    `json:"number"`
+}
+
+type CommentCampaignStatus struct {
+	// Targets records the delivery to every issue the campaign matched.
+	Targets []CommentCampaignTarget `json:"targets,omitempty"`
+
+	Delivered int `json:"delivered"`
+	Failed    int `json:"failed"`
+}
+
+type CommentCampaignTarget struct {
+	Owner      string `json:"owner"`
+	Repository string `json:"repository"`
+	Number     int    `json:"number"`
+
+	Delivered bool   `json:"delivered"`
+	CommentID int64  `json:"commentID,omitempty"`
+	URL       string `json:"url,omitempty"`
+	// Error is the error of the last failed delivery.
+	Error string `json:"error,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CommentCampaignList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Items []CommentCampaign `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=clustercomments
+
+// ClusterComment is a cluster-scoped Comment. It lets platform teams own
+// announcements that no tenant namespace can edit or delete.
+type ClusterComment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   CommentSpec   `json:"spec"`
+	Status CommentStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterCommentList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Items []ClusterComment `json:"items"`
+}
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=notificationrules
+
+// NotificationRule posts a comment whenever objects of any resource are
+// created, updated or deleted, or when a value read from them changes.
+type NotificationRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   NotificationRuleSpec   `json:"spec"`
+	Status NotificationRuleStatus `json:"status,omitempty"`
+}
+
+type NotificationRuleSpec struct {
+	// Group, Version and Resource name the resource that is watched, e.g.
+	// "apps", "v1" and "deployments". Group is empty for the core group.
+	Group    string `json:"group,omitempty"`
+	Version  string `json:"version"`
+	Resource string `json:"resource"`
+
+	// Namespace must be empty or the namespace of the rule. Only objects in
+	// the namespace of the rule are watched.
+	Namespace     string `json:"namespace,omitempty"`
+	LabelSelector string `json:"labelSelector,omitempty"`
+	FieldSelector string `json:"fieldSelector,omitempty"`
+
+	// Events lists the changes the rule fires on, out of "create",
+	// "update" and "delete".
+	Events []string `json:"events,omitempty"`
+	// Condition is a JSONPath expression, e.g.
+	// {.status.conditions[?(@.type=="Available")].status}. The rule fires
+	// whenever its value changes on update.
+	Condition string `json:"condition,omitempty"`
+
+	// Message is a text/template rendered with .Event, .Object, .OldObject,
+	// .Condition and .OldCondition set.
+	Message string `json:"message"`
+	// Target is the issue the comments are posted on.
+	Target IssueReference `json:"target"`
+}
+
+type NotificationRuleStatus struct {
+	// Fired counts the comments posted for the rule.
+	Fired       int         `json:"fired"`
+	LastFiredAt metav1.Time `json:"lastFiredAt,omitempty"`
+	// Error is the last error watching the resource or posting a comment.
+	Error string `json:"error,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NotificationRuleList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Items []NotificationRule `json:"items"`
+}
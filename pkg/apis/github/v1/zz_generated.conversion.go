@@ -35,17 +35,273 @@ func init() {
 // Public to allow building arbitrary schemes.
 func RegisterConversions(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedConversionFuncs(
+		Convert_v1_ActionsSecret_To_github_ActionsSecret,
+		Convert_github_ActionsSecret_To_v1_ActionsSecret,
+		Convert_v1_ActionsSecretKey_To_github_ActionsSecretKey,
+		Convert_github_ActionsSecretKey_To_v1_ActionsSecretKey,
+		Convert_v1_ActionsSecretList_To_github_ActionsSecretList,
+		Convert_github_ActionsSecretList_To_v1_ActionsSecretList,
+		Convert_v1_ActionsSecretSpec_To_github_ActionsSecretSpec,
+		Convert_github_ActionsSecretSpec_To_v1_ActionsSecretSpec,
+		Convert_v1_ActionsSecretStatus_To_github_ActionsSecretStatus,
+		Convert_github_ActionsSecretStatus_To_v1_ActionsSecretStatus,
+		Convert_v1_ClusterComment_To_github_ClusterComment,
+		Convert_github_ClusterComment_To_v1_ClusterComment,
+		Convert_v1_ClusterCommentList_To_github_ClusterCommentList,
+		Convert_github_ClusterCommentList_To_v1_ClusterCommentList,
 		Convert_v1_Comment_To_github_Comment,
 		Convert_github_Comment_To_v1_Comment,
+		Convert_v1_CommentCampaign_To_github_CommentCampaign,
+		Convert_github_CommentCampaign_To_v1_CommentCampaign,
+		Convert_v1_CommentCampaignList_To_github_CommentCampaignList,
+		Convert_github_CommentCampaignList_To_v1_CommentCampaignList,
+		Convert_v1_CommentCampaignSpec_To_github_CommentCampaignSpec,
+		Convert_github_CommentCampaignSpec_To_v1_CommentCampaignSpec,
+		Convert_v1_CommentCampaignStatus_To_github_CommentCampaignStatus,
+		Convert_github_CommentCampaignStatus_To_v1_CommentCampaignStatus,
+		Convert_v1_CommentCampaignTarget_To_github_CommentCampaignTarget,
+		Convert_github_CommentCampaignTarget_To_v1_CommentCampaignTarget,
 		Convert_v1_CommentList_To_github_CommentList,
 		Convert_github_CommentList_To_v1_CommentList,
 		Convert_v1_CommentSpec_To_github_CommentSpec,
 		Convert_github_CommentSpec_To_v1_CommentSpec,
 		Convert_v1_CommentStatus_To_github_CommentStatus,
 		Convert_github_CommentStatus_To_v1_CommentStatus,
+		Convert_v1_Discussion_To_github_Discussion,
+		Convert_github_Discussion_To_v1_Discussion,
+		Convert_v1_DiscussionList_To_github_DiscussionList,
+		Convert_github_DiscussionList_To_v1_DiscussionList,
+		Convert_v1_DiscussionSpec_To_github_DiscussionSpec,
+		Convert_github_DiscussionSpec_To_v1_DiscussionSpec,
+		Convert_v1_DiscussionStatus_To_github_DiscussionStatus,
+		Convert_github_DiscussionStatus_To_v1_DiscussionStatus,
+		Convert_v1_IssueReference_To_github_IssueReference,
+		Convert_github_IssueReference_To_v1_IssueReference,
+		Convert_v1_NotificationRule_To_github_NotificationRule,
+		Convert_github_NotificationRule_To_v1_NotificationRule,
+		Convert_v1_NotificationRuleList_To_github_NotificationRuleList,
+		Convert_github_NotificationRuleList_To_v1_NotificationRuleList,
+		Convert_v1_NotificationRuleSpec_To_github_NotificationRuleSpec,
+		Convert_github_NotificationRuleSpec_To_v1_NotificationRuleSpec,
+		Convert_v1_NotificationRuleStatus_To_github_NotificationRuleStatus,
+		Convert_github_NotificationRuleStatus_To_v1_NotificationRuleStatus,
+		Convert_v1_PullRequest_To_github_PullRequest,
+		Convert_github_PullRequest_To_v1_PullRequest,
+		Convert_v1_PullRequestList_To_github_PullRequestList,
+		Convert_github_PullRequestList_To_v1_PullRequestList,
+		Convert_v1_PullRequestSpec_To_github_PullRequestSpec,
+		Convert_github_PullRequestSpec_To_v1_PullRequestSpec,
+		Convert_v1_PullRequestStatus_To_github_PullRequestStatus,
+		Convert_github_PullRequestStatus_To_v1_PullRequestStatus,
+		Convert_v1_RepositoryFile_To_github_RepositoryFile,
+		Convert_github_RepositoryFile_To_v1_RepositoryFile,
+		Convert_v1_RepositoryFileList_To_github_RepositoryFileList,
+		Convert_github_RepositoryFileList_To_v1_RepositoryFileList,
+		Convert_v1_RepositoryFileSpec_To_github_RepositoryFileSpec,
+		Convert_github_RepositoryFileSpec_To_v1_RepositoryFileSpec,
+		Convert_v1_RepositoryFileStatus_To_github_RepositoryFileStatus,
+		Convert_github_RepositoryFileStatus_To_v1_RepositoryFileStatus,
+		Convert_v1_WorkflowTrigger_To_github_WorkflowTrigger,
+		Convert_github_WorkflowTrigger_To_v1_WorkflowTrigger,
+		Convert_v1_WorkflowTriggerCondition_To_github_WorkflowTriggerCondition,
+		Convert_github_WorkflowTriggerCondition_To_v1_WorkflowTriggerCondition,
+		Convert_v1_WorkflowTriggerList_To_github_WorkflowTriggerList,
+		Convert_github_WorkflowTriggerList_To_v1_WorkflowTriggerList,
+		Convert_v1_WorkflowTriggerSpec_To_github_WorkflowTriggerSpec,
+		Convert_github_WorkflowTriggerSpec_To_v1_WorkflowTriggerSpec,
+		Convert_v1_WorkflowTriggerStatus_To_github_WorkflowTriggerStatus,
+		Convert_github_WorkflowTriggerStatus_To_v1_WorkflowTriggerStatus,
 	)
 }
 
+func autoConvert_v1_ActionsSecret_To_github_ActionsSecret(in *ActionsSecret, out *github.ActionsSecret, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ActionsSecretSpec_To_github_ActionsSecretSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ActionsSecretStatus_To_github_ActionsSecretStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ActionsSecret_To_github_ActionsSecret is an autogenerated conversion function.
+func Convert_v1_ActionsSecret_To_github_ActionsSecret(in *ActionsSecret, out *github.ActionsSecret, s conversion.Scope) error {
+	return autoConvert_v1_ActionsSecret_To_github_ActionsSecret(in, out, s)
+}
+
+func autoConvert_github_ActionsSecret_To_v1_ActionsSecret(in *github.ActionsSecret, out *ActionsSecret, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_github_ActionsSecretSpec_To_v1_ActionsSecretSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_github_ActionsSecretStatus_To_v1_ActionsSecretStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_github_ActionsSecret_To_v1_ActionsSecret is an autogenerated conversion function.
+func Convert_github_ActionsSecret_To_v1_ActionsSecret(in *github.ActionsSecret, out *ActionsSecret, s conversion.Scope) error {
+	return autoConvert_github_ActionsSecret_To_v1_ActionsSecret(in, out, s)
+}
+
+func autoConvert_v1_ActionsSecretKey_To_github_ActionsSecretKey(in *ActionsSecretKey, out *github.ActionsSecretKey, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1_ActionsSecretKey_To_github_ActionsSecretKey is an autogenerated conversion function.
+func Convert_v1_ActionsSecretKey_To_github_ActionsSecretKey(in *ActionsSecretKey, out *github.ActionsSecretKey, s conversion.Scope) error {
+	return autoConvert_v1_ActionsSecretKey_To_github_ActionsSecretKey(in, out, s)
+}
+
+func autoConvert_github_ActionsSecretKey_To_v1_ActionsSecretKey(in *github.ActionsSecretKey, out *ActionsSecretKey, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_github_ActionsSecretKey_To_v1_ActionsSecretKey is an autogenerated conversion function.
+func Convert_github_ActionsSecretKey_To_v1_ActionsSecretKey(in *github.ActionsSecretKey, out *ActionsSecretKey, s conversion.Scope) error {
+	return autoConvert_github_ActionsSecretKey_To_v1_ActionsSecretKey(in, out, s)
+}
+
+func autoConvert_v1_ActionsSecretList_To_github_ActionsSecretList(in *ActionsSecretList, out *github.ActionsSecretList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]github.ActionsSecret)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ActionsSecretList_To_github_ActionsSecretList is an autogenerated conversion function.
+func Convert_v1_ActionsSecretList_To_github_ActionsSecretList(in *ActionsSecretList, out *github.ActionsSecretList, s conversion.Scope) error {
+	return autoConvert_v1_ActionsSecretList_To_github_ActionsSecretList(in, out, s)
+}
+
+func autoConvert_github_ActionsSecretList_To_v1_ActionsSecretList(in *github.ActionsSecretList, out *ActionsSecretList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]ActionsSecret)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_github_ActionsSecretList_To_v1_ActionsSecretList is an autogenerated conversion function.
+func Convert_github_ActionsSecretList_To_v1_ActionsSecretList(in *github.ActionsSecretList, out *ActionsSecretList, s conversion.Scope) error {
+	return autoConvert_github_ActionsSecretList_To_v1_ActionsSecretList(in, out, s)
+}
+
+func autoConvert_v1_ActionsSecretSpec_To_github_ActionsSecretSpec(in *ActionsSecretSpec, out *github.ActionsSecretSpec, s conversion.Scope) error {
+	out.Scope = in.Scope
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Environment = in.Environment
+	out.Visibility = in.Visibility
+	out.SecretName = in.SecretName
+	out.SecretKeys = *(*[]github.ActionsSecretKey)(unsafe.Pointer(&in.SecretKeys))
+	out.ConfigMapName = in.ConfigMapName
+	out.ConfigMapKeys = *(*[]github.ActionsSecretKey)(unsafe.Pointer(&in.ConfigMapKeys))
+	return nil
+}
+
+// Convert_v1_ActionsSecretSpec_To_github_ActionsSecretSpec is an autogenerated conversion function.
+func Convert_v1_ActionsSecretSpec_To_github_ActionsSecretSpec(in *ActionsSecretSpec, out *github.ActionsSecretSpec, s conversion.Scope) error {
+	return autoConvert_v1_ActionsSecretSpec_To_github_ActionsSecretSpec(in, out, s)
+}
+
+func autoConvert_github_ActionsSecretSpec_To_v1_ActionsSecretSpec(in *github.ActionsSecretSpec, out *ActionsSecretSpec, s conversion.Scope) error {
+	out.Scope = in.Scope
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Environment = in.Environment
+	out.Visibility = in.Visibility
+	out.SecretName = in.SecretName
+	out.SecretKeys = *(*[]ActionsSecretKey)(unsafe.Pointer(&in.SecretKeys))
+	out.ConfigMapName = in.ConfigMapName
+	out.ConfigMapKeys = *(*[]ActionsSecretKey)(unsafe.Pointer(&in.ConfigMapKeys))
+	return nil
+}
+
+// Convert_github_ActionsSecretSpec_To_v1_ActionsSecretSpec is an autogenerated conversion function.
+func Convert_github_ActionsSecretSpec_To_v1_ActionsSecretSpec(in *github.ActionsSecretSpec, out *ActionsSecretSpec, s conversion.Scope) error {
+	return autoConvert_github_ActionsSecretSpec_To_v1_ActionsSecretSpec(in, out, s)
+}
+
+func autoConvert_v1_ActionsSecretStatus_To_github_ActionsSecretStatus(in *ActionsSecretStatus, out *github.ActionsSecretStatus, s conversion.Scope) error {
+	out.Secrets = *(*map[string]string)(unsafe.Pointer(&in.Secrets))
+	out.Variables = *(*map[string]string)(unsafe.Pointer(&in.Variables))
+	return nil
+}
+
+// Convert_v1_ActionsSecretStatus_To_github_ActionsSecretStatus is an autogenerated conversion function.
+func Convert_v1_ActionsSecretStatus_To_github_ActionsSecretStatus(in *ActionsSecretStatus, out *github.ActionsSecretStatus, s conversion.Scope) error {
+	return autoConvert_v1_ActionsSecretStatus_To_github_ActionsSecretStatus(in, out, s)
+}
+
+func autoConvert_github_ActionsSecretStatus_To_v1_ActionsSecretStatus(in *github.ActionsSecretStatus, out *ActionsSecretStatus, s conversion.Scope) error {
+	out.Secrets = *(*map[string]string)(unsafe.Pointer(&in.Secrets))
+	out.Variables = *(*map[string]string)(unsafe.Pointer(&in.Variables))
+	return nil
+}
+
+// Convert_github_ActionsSecretStatus_To_v1_ActionsSecretStatus is an autogenerated conversion function.
+func Convert_github_ActionsSecretStatus_To_v1_ActionsSecretStatus(in *github.ActionsSecretStatus, out *ActionsSecretStatus, s conversion.Scope) error {
+	return autoConvert_github_ActionsSecretStatus_To_v1_ActionsSecretStatus(in, out, s)
+}
+
+func autoConvert_v1_ClusterComment_To_github_ClusterComment(in *ClusterComment, out *github.ClusterComment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CommentSpec_To_github_CommentSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_CommentStatus_To_github_CommentStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ClusterComment_To_github_ClusterComment is an autogenerated conversion function.
+func Convert_v1_ClusterComment_To_github_ClusterComment(in *ClusterComment, out *github.ClusterComment, s conversion.Scope) error {
+	return autoConvert_v1_ClusterComment_To_github_ClusterComment(in, out, s)
+}
+
+func autoConvert_github_ClusterComment_To_v1_ClusterComment(in *github.ClusterComment, out *ClusterComment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_github_CommentSpec_To_v1_CommentSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_github_CommentStatus_To_v1_CommentStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_github_ClusterComment_To_v1_ClusterComment is an autogenerated conversion function.
+func Convert_github_ClusterComment_To_v1_ClusterComment(in *github.ClusterComment, out *ClusterComment, s conversion.Scope) error {
+	return autoConvert_github_ClusterComment_To_v1_ClusterComment(in, out, s)
+}
+
+func autoConvert_v1_ClusterCommentList_To_github_ClusterCommentList(in *ClusterCommentList, out *github.ClusterCommentList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]github.ClusterComment)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ClusterCommentList_To_github_ClusterCommentList is an autogenerated conversion function.
+func Convert_v1_ClusterCommentList_To_github_ClusterCommentList(in *ClusterCommentList, out *github.ClusterCommentList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterCommentList_To_github_ClusterCommentList(in, out, s)
+}
+
+func autoConvert_github_ClusterCommentList_To_v1_ClusterCommentList(in *github.ClusterCommentList, out *ClusterCommentList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]ClusterComment)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_github_ClusterCommentList_To_v1_ClusterCommentList is an autogenerated conversion function.
+func Convert_github_ClusterCommentList_To_v1_ClusterCommentList(in *github.ClusterCommentList, out *ClusterCommentList, s conversion.Scope) error {
+	return autoConvert_github_ClusterCommentList_To_v1_ClusterCommentList(in, out, s)
+}
+
 func autoConvert_v1_Comment_To_github_Comment(in *Comment, out *github.Comment, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_CommentSpec_To_github_CommentSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -78,6 +334,142 @@ func Convert_github_Comment_To_v1_Comment(in *github.Comment, out *Comment, s co
 	return autoConvert_github_Comment_To_v1_Comment(in, out, s)
 }
 
+func autoConvert_v1_CommentCampaign_To_github_CommentCampaign(in *CommentCampaign, out *github.CommentCampaign, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CommentCampaignSpec_To_github_CommentCampaignSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_CommentCampaignStatus_To_github_CommentCampaignStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CommentCampaign_To_github_CommentCampaign is an autogenerated conversion function.
+func Convert_v1_CommentCampaign_To_github_CommentCampaign(in *CommentCampaign, out *github.CommentCampaign, s conversion.Scope) error {
+	return autoConvert_v1_CommentCampaign_To_github_CommentCampaign(in, out, s)
+}
+
+func autoConvert_github_CommentCampaign_To_v1_CommentCampaign(in *github.CommentCampaign, out *CommentCampaign, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_github_CommentCampaignSpec_To_v1_CommentCampaignSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_github_CommentCampaignStatus_To_v1_CommentCampaignStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_github_CommentCampaign_To_v1_CommentCampaign is an autogenerated conversion function.
+func Convert_github_CommentCampaign_To_v1_CommentCampaign(in *github.CommentCampaign, out *CommentCampaign, s conversion.Scope) error {
+	return autoConvert_github_CommentCampaign_To_v1_CommentCampaign(in, out, s)
+}
+
+func autoConvert_v1_CommentCampaignList_To_github_CommentCampaignList(in *CommentCampaignList, out *github.CommentCampaignList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]github.CommentCampaign)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_CommentCampaignList_To_github_CommentCampaignList is an autogenerated conversion function.
+func Convert_v1_CommentCampaignList_To_github_CommentCampaignList(in *CommentCampaignList, out *github.CommentCampaignList, s conversion.Scope) error {
+	return autoConvert_v1_CommentCampaignList_To_github_CommentCampaignList(in, out, s)
+}
+
+func autoConvert_github_CommentCampaignList_To_v1_CommentCampaignList(in *github.CommentCampaignList, out *CommentCampaignList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]CommentCampaign)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_github_CommentCampaignList_To_v1_CommentCampaignList is an autogenerated conversion function.
+func Convert_github_CommentCampaignList_To_v1_CommentCampaignList(in *github.CommentCampaignList, out *CommentCampaignList, s conversion.Scope) error {
+	return autoConvert_github_CommentCampaignList_To_v1_CommentCampaignList(in, out, s)
+}
+
+func autoConvert_v1_CommentCampaignSpec_To_github_CommentCampaignSpec(in *CommentCampaignSpec, out *github.CommentCampaignSpec, s conversion.Scope) error {
+	out.Query = in.Query
+	out.Issues = *(*[]github.IssueReference)(unsafe.Pointer(&in.Issues))
+	out.Message = in.Message
+	out.Concurrency = in.Concurrency
+	return nil
+}
+
+// Convert_v1_CommentCampaignSpec_To_github_CommentCampaignSpec is an autogenerated conversion function.
+func Convert_v1_CommentCampaignSpec_To_github_CommentCampaignSpec(in *CommentCampaignSpec, out *github.CommentCampaignSpec, s conversion.Scope) error {
+	return autoConvert_v1_CommentCampaignSpec_To_github_CommentCampaignSpec(in, out, s)
+}
+
+func autoConvert_github_CommentCampaignSpec_To_v1_CommentCampaignSpec(in *github.CommentCampaignSpec, out *CommentCampaignSpec, s conversion.Scope) error {
+	out.Query = in.Query
+	out.Issues = *(*[]IssueReference)(unsafe.Pointer(&in.Issues))
+	out.Message = in.Message
+	out.Concurrency = in.Concurrency
+	return nil
+}
+
+// Convert_github_CommentCampaignSpec_To_v1_CommentCampaignSpec is an autogenerated conversion function.
+func Convert_github_CommentCampaignSpec_To_v1_CommentCampaignSpec(in *github.CommentCampaignSpec, out *CommentCampaignSpec, s conversion.Scope) error {
+	return autoConvert_github_CommentCampaignSpec_To_v1_CommentCampaignSpec(in, out, s)
+}
+
+func autoConvert_v1_CommentCampaignStatus_To_github_CommentCampaignStatus(in *CommentCampaignStatus, out *github.CommentCampaignStatus, s conversion.Scope) error {
+	out.Targets = *(*[]github.CommentCampaignTarget)(unsafe.Pointer(&in.Targets))
+	out.Delivered = in.Delivered
+	out.Failed = in.Failed
+	return nil
+}
+
+// Convert_v1_CommentCampaignStatus_To_github_CommentCampaignStatus is an autogenerated conversion function.
+func Convert_v1_CommentCampaignStatus_To_github_CommentCampaignStatus(in *CommentCampaignStatus, out *github.CommentCampaignStatus, s conversion.Scope) error {
+	return autoConvert_v1_CommentCampaignStatus_To_github_CommentCampaignStatus(in, out, s)
+}
+
+func autoConvert_github_CommentCampaignStatus_To_v1_CommentCampaignStatus(in *github.CommentCampaignStatus, out *CommentCampaignStatus, s conversion.Scope) error {
+	out.Targets = *(*[]CommentCampaignTarget)(unsafe.Pointer(&in.Targets))
+	out.Delivered = in.Delivered
+	out.Failed = in.Failed
+	return nil
+}
+
+// Convert_github_CommentCampaignStatus_To_v1_CommentCampaignStatus is an autogenerated conversion function.
+func Convert_github_CommentCampaignStatus_To_v1_CommentCampaignStatus(in *github.CommentCampaignStatus, out *CommentCampaignStatus, s conversion.Scope) error {
+	return autoConvert_github_CommentCampaignStatus_To_v1_CommentCampaignStatus(in, out, s)
+}
+
+func autoConvert_v1_CommentCampaignTarget_To_github_CommentCampaignTarget(in *CommentCampaignTarget, out *github.CommentCampaignTarget, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Number = in.Number
+	out.Delivered = in.Delivered
+	out.CommentID = in.CommentID
+	out.URL = in.URL
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_v1_CommentCampaignTarget_To_github_CommentCampaignTarget is an autogenerated conversion function.
+func Convert_v1_CommentCampaignTarget_To_github_CommentCampaignTarget(in *CommentCampaignTarget, out *github.CommentCampaignTarget, s conversion.Scope) error {
+	return autoConvert_v1_CommentCampaignTarget_To_github_CommentCampaignTarget(in, out, s)
+}
+
+func autoConvert_github_CommentCampaignTarget_To_v1_CommentCampaignTarget(in *github.CommentCampaignTarget, out *CommentCampaignTarget, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Number = in.Number
+	out.Delivered = in.Delivered
+	out.CommentID = in.CommentID
+	out.URL = in.URL
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_github_CommentCampaignTarget_To_v1_CommentCampaignTarget is an autogenerated conversion function.
+func Convert_github_CommentCampaignTarget_To_v1_CommentCampaignTarget(in *github.CommentCampaignTarget, out *CommentCampaignTarget, s conversion.Scope) error {
+	return autoConvert_github_CommentCampaignTarget_To_v1_CommentCampaignTarget(in, out, s)
+}
+
 func autoConvert_v1_CommentList_To_github_CommentList(in *CommentList, out *github.CommentList, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Items = *(*[]github.Comment)(unsafe.Pointer(&in.Items))
@@ -102,6 +494,10 @@ func Convert_github_CommentList_To_v1_CommentList(in *github.CommentList, out *C
 
 func autoConvert_v1_CommentSpec_To_github_CommentSpec(in *CommentSpec, out *github.CommentSpec, s conversion.Scope) error {
 	out.Message = in.Message
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Number = in.Number
+	out.Observed = in.Observed
 	return nil
 }
 
@@ -112,6 +508,10 @@ func Convert_v1_CommentSpec_To_github_CommentSpec(in *CommentSpec, out *github.C
 
 func autoConvert_github_CommentSpec_To_v1_CommentSpec(in *github.CommentSpec, out *CommentSpec, s conversion.Scope) error {
 	out.Message = in.Message
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Number = in.Number
+	out.Observed = in.Observed
 	return nil
 }
 
@@ -122,6 +522,13 @@ func Convert_github_CommentSpec_To_v1_CommentSpec(in *github.CommentSpec, out *C
 
 func autoConvert_v1_CommentStatus_To_github_CommentStatus(in *CommentStatus, out *github.CommentStatus, s conversion.Scope) error {
 	out.Created = in.Created
+	out.CommentID = in.CommentID
+	out.URL = in.URL
+	out.MessageHash = in.MessageHash
+	out.Author = in.Author
+	out.CreatedAt = in.CreatedAt
+	out.UpdatedAt = in.UpdatedAt
+	out.RemoteDeleted = in.RemoteDeleted
 	return nil
 }
 
@@ -132,6 +539,13 @@ func Convert_v1_CommentStatus_To_github_CommentStatus(in *CommentStatus, out *gi
 
 func autoConvert_github_CommentStatus_To_v1_CommentStatus(in *github.CommentStatus, out *CommentStatus, s conversion.Scope) error {
 	out.Created = in.Created
+	out.CommentID = in.CommentID
+	out.URL = in.URL
+	out.MessageHash = in.MessageHash
+	out.Author = in.Author
+	out.CreatedAt = in.CreatedAt
+	out.UpdatedAt = in.UpdatedAt
+	out.RemoteDeleted = in.RemoteDeleted
 	return nil
 }
 
@@ -139,3 +553,657 @@ func autoConvert_github_CommentStatus_To_v1_CommentStatus(in *github.CommentStat
 func Convert_github_CommentStatus_To_v1_CommentStatus(in *github.CommentStatus, out *CommentStatus, s conversion.Scope) error {
 	return autoConvert_github_CommentStatus_To_v1_CommentStatus(in, out, s)
 }
+
+func autoConvert_v1_Discussion_To_github_Discussion(in *Discussion, out *github.Discussion, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_DiscussionSpec_To_github_DiscussionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_DiscussionStatus_To_github_DiscussionStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Discussion_To_github_Discussion is an autogenerated conversion function.
+func Convert_v1_Discussion_To_github_Discussion(in *Discussion, out *github.Discussion, s conversion.Scope) error {
+	return autoConvert_v1_Discussion_To_github_Discussion(in, out, s)
+}
+
+func autoConvert_github_Discussion_To_v1_Discussion(in *github.Discussion, out *Discussion, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_github_DiscussionSpec_To_v1_DiscussionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_github_DiscussionStatus_To_v1_DiscussionStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_github_Discussion_To_v1_Discussion is an autogenerated conversion function.
+func Convert_github_Discussion_To_v1_Discussion(in *github.Discussion, out *Discussion, s conversion.Scope) error {
+	return autoConvert_github_Discussion_To_v1_Discussion(in, out, s)
+}
+
+func autoConvert_v1_DiscussionList_To_github_DiscussionList(in *DiscussionList, out *github.DiscussionList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]github.Discussion)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_DiscussionList_To_github_DiscussionList is an autogenerated conversion function.
+func Convert_v1_DiscussionList_To_github_DiscussionList(in *DiscussionList, out *github.DiscussionList, s conversion.Scope) error {
+	return autoConvert_v1_DiscussionList_To_github_DiscussionList(in, out, s)
+}
+
+func autoConvert_github_DiscussionList_To_v1_DiscussionList(in *github.DiscussionList, out *DiscussionList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]Discussion)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_github_DiscussionList_To_v1_DiscussionList is an autogenerated conversion function.
+func Convert_github_DiscussionList_To_v1_DiscussionList(in *github.DiscussionList, out *DiscussionList, s conversion.Scope) error {
+	return autoConvert_github_DiscussionList_To_v1_DiscussionList(in, out, s)
+}
+
+func autoConvert_v1_DiscussionSpec_To_github_DiscussionSpec(in *DiscussionSpec, out *github.DiscussionSpec, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Category = in.Category
+	out.Title = in.Title
+	out.Body = in.Body
+	out.ReplyTo = in.ReplyTo
+	return nil
+}
+
+// Convert_v1_DiscussionSpec_To_github_DiscussionSpec is an autogenerated conversion function.
+func Convert_v1_DiscussionSpec_To_github_DiscussionSpec(in *DiscussionSpec, out *github.DiscussionSpec, s conversion.Scope) error {
+	return autoConvert_v1_DiscussionSpec_To_github_DiscussionSpec(in, out, s)
+}
+
+func autoConvert_github_DiscussionSpec_To_v1_DiscussionSpec(in *github.DiscussionSpec, out *DiscussionSpec, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Category = in.Category
+	out.Title = in.Title
+	out.Body = in.Body
+	out.ReplyTo = in.ReplyTo
+	return nil
+}
+
+// Convert_github_DiscussionSpec_To_v1_DiscussionSpec is an autogenerated conversion function.
+func Convert_github_DiscussionSpec_To_v1_DiscussionSpec(in *github.DiscussionSpec, out *DiscussionSpec, s conversion.Scope) error {
+	return autoConvert_github_DiscussionSpec_To_v1_DiscussionSpec(in, out, s)
+}
+
+func autoConvert_v1_DiscussionStatus_To_github_DiscussionStatus(in *DiscussionStatus, out *github.DiscussionStatus, s conversion.Scope) error {
+	out.Created = in.Created
+	out.DiscussionNodeID = in.DiscussionNodeID
+	out.NodeID = in.NodeID
+	out.URL = in.URL
+	out.BodyHash = in.BodyHash
+	return nil
+}
+
+// Convert_v1_DiscussionStatus_To_github_DiscussionStatus is an autogenerated conversion function.
+func Convert_v1_DiscussionStatus_To_github_DiscussionStatus(in *DiscussionStatus, out *github.DiscussionStatus, s conversion.Scope) error {
+	return autoConvert_v1_DiscussionStatus_To_github_DiscussionStatus(in, out, s)
+}
+
+func autoConvert_github_DiscussionStatus_To_v1_DiscussionStatus(in *github.DiscussionStatus, out *DiscussionStatus, s conversion.Scope) error {
+	out.Created = in.Created
+	out.DiscussionNodeID = in.DiscussionNodeID
+	out.NodeID = in.NodeID
+	out.URL = in.URL
+	out.BodyHash = in.BodyHash
+	return nil
+}
+
+// Convert_github_DiscussionStatus_To_v1_DiscussionStatus is an autogenerated conversion function.
+func Convert_github_DiscussionStatus_To_v1_DiscussionStatus(in *github.DiscussionStatus, out *DiscussionStatus, s conversion.Scope) error {
+	return autoConvert_github_DiscussionStatus_To_v1_DiscussionStatus(in, out, s)
+}
+
+func autoConvert_v1_IssueReference_To_github_IssueReference(in *IssueReference, out *github.IssueReference, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Number = in.Number
+	return nil
+}
+
+// Convert_v1_IssueReference_To_github_IssueReference is an autogenerated conversion function.
+func Convert_v1_IssueReference_To_github_IssueReference(in *IssueReference, out *github.IssueReference, s conversion.Scope) error {
+	return autoConvert_v1_IssueReference_To_github_IssueReference(in, out, s)
+}
+
+func autoConvert_github_IssueReference_To_v1_IssueReference(in *github.IssueReference, out *IssueReference, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Number = in.Number
+	return nil
+}
+
+// Convert_github_IssueReference_To_v1_IssueReference is an autogenerated conversion function.
+func Convert_github_IssueReference_To_v1_IssueReference(in *github.IssueReference, out *IssueReference, s conversion.Scope) error {
+	return autoConvert_github_IssueReference_To_v1_IssueReference(in, out, s)
+}
+
+func autoConvert_v1_NotificationRule_To_github_NotificationRule(in *NotificationRule, out *github.NotificationRule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_NotificationRuleSpec_To_github_NotificationRuleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_NotificationRuleStatus_To_github_NotificationRuleStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_NotificationRule_To_github_NotificationRule is an autogenerated conversion function.
+func Convert_v1_NotificationRule_To_github_NotificationRule(in *NotificationRule, out *github.NotificationRule, s conversion.Scope) error {
+	return autoConvert_v1_NotificationRule_To_github_NotificationRule(in, out, s)
+}
+
+func autoConvert_github_NotificationRule_To_v1_NotificationRule(in *github.NotificationRule, out *NotificationRule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_github_NotificationRuleSpec_To_v1_NotificationRuleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_github_NotificationRuleStatus_To_v1_NotificationRuleStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_github_NotificationRule_To_v1_NotificationRule is an autogenerated conversion function.
+func Convert_github_NotificationRule_To_v1_NotificationRule(in *github.NotificationRule, out *NotificationRule, s conversion.Scope) error {
+	return autoConvert_github_NotificationRule_To_v1_NotificationRule(in, out, s)
+}
+
+func autoConvert_v1_NotificationRuleList_To_github_NotificationRuleList(in *NotificationRuleList, out *github.NotificationRuleList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]github.NotificationRule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_NotificationRuleList_To_github_NotificationRuleList is an autogenerated conversion function.
+func Convert_v1_NotificationRuleList_To_github_NotificationRuleList(in *NotificationRuleList, out *github.NotificationRuleList, s conversion.Scope) error {
+	return autoConvert_v1_NotificationRuleList_To_github_NotificationRuleList(in, out, s)
+}
+
+func autoConvert_github_NotificationRuleList_To_v1_NotificationRuleList(in *github.NotificationRuleList, out *NotificationRuleList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]NotificationRule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_github_NotificationRuleList_To_v1_NotificationRuleList is an autogenerated conversion function.
+func Convert_github_NotificationRuleList_To_v1_NotificationRuleList(in *github.NotificationRuleList, out *NotificationRuleList, s conversion.Scope) error {
+	return autoConvert_github_NotificationRuleList_To_v1_NotificationRuleList(in, out, s)
+}
+
+func autoConvert_v1_NotificationRuleSpec_To_github_NotificationRuleSpec(in *NotificationRuleSpec, out *github.NotificationRuleSpec, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Resource = in.Resource
+	out.Namespace = in.Namespace
+	out.LabelSelector = in.LabelSelector
+	out.FieldSelector = in.FieldSelector
+	out.Events = *(*[]string)(unsafe.Pointer(&in.Events))
+	out.Condition = in.Condition
+	out.Message = in.Message
+	if err := Convert_v1_IssueReference_To_github_IssueReference(&in.Target, &out.Target, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_NotificationRuleSpec_To_github_NotificationRuleSpec is an autogenerated conversion function.
+func Convert_v1_NotificationRuleSpec_To_github_NotificationRuleSpec(in *NotificationRuleSpec, out *github.NotificationRuleSpec, s conversion.Scope) error {
+	return autoConvert_v1_NotificationRuleSpec_To_github_NotificationRuleSpec(in, out, s)
+}
+
+func autoConvert_github_NotificationRuleSpec_To_v1_NotificationRuleSpec(in *github.NotificationRuleSpec, out *NotificationRuleSpec, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Resource = in.Resource
+	out.Namespace = in.Namespace
+	out.LabelSelector = in.LabelSelector
+	out.FieldSelector = in.FieldSelector
+	out.Events = *(*[]string)(unsafe.Pointer(&in.Events))
+	out.Condition = in.Condition
+	out.Message = in.Message
+	if err := Convert_github_IssueReference_To_v1_IssueReference(&in.Target, &out.Target, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_github_NotificationRuleSpec_To_v1_NotificationRuleSpec is an autogenerated conversion function.
+func Convert_github_NotificationRuleSpec_To_v1_NotificationRuleSpec(in *github.NotificationRuleSpec, out *NotificationRuleSpec, s conversion.Scope) error {
+	return autoConvert_github_NotificationRuleSpec_To_v1_NotificationRuleSpec(in, out, s)
+}
+
+func autoConvert_v1_NotificationRuleStatus_To_github_NotificationRuleStatus(in *NotificationRuleStatus, out *github.NotificationRuleStatus, s conversion.Scope) error {
+	out.Fired = in.Fired
+	out.LastFiredAt = in.LastFiredAt
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_v1_NotificationRuleStatus_To_github_NotificationRuleStatus is an autogenerated conversion function.
+func Convert_v1_NotificationRuleStatus_To_github_NotificationRuleStatus(in *NotificationRuleStatus, out *github.NotificationRuleStatus, s conversion.Scope) error {
+	return autoConvert_v1_NotificationRuleStatus_To_github_NotificationRuleStatus(in, out, s)
+}
+
+func autoConvert_github_NotificationRuleStatus_To_v1_NotificationRuleStatus(in *github.NotificationRuleStatus, out *NotificationRuleStatus, s conversion.Scope) error {
+	out.Fired = in.Fired
+	out.LastFiredAt = in.LastFiredAt
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_github_NotificationRuleStatus_To_v1_NotificationRuleStatus is an autogenerated conversion function.
+func Convert_github_NotificationRuleStatus_To_v1_NotificationRuleStatus(in *github.NotificationRuleStatus, out *NotificationRuleStatus, s conversion.Scope) error {
+	return autoConvert_github_NotificationRuleStatus_To_v1_NotificationRuleStatus(in, out, s)
+}
+
+func autoConvert_v1_PullRequest_To_github_PullRequest(in *PullRequest, out *github.PullRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_PullRequestSpec_To_github_PullRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_PullRequestStatus_To_github_PullRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_PullRequest_To_github_PullRequest is an autogenerated conversion function.
+func Convert_v1_PullRequest_To_github_PullRequest(in *PullRequest, out *github.PullRequest, s conversion.Scope) error {
+	return autoConvert_v1_PullRequest_To_github_PullRequest(in, out, s)
+}
+
+func autoConvert_github_PullRequest_To_v1_PullRequest(in *github.PullRequest, out *PullRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_github_PullRequestSpec_To_v1_PullRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_github_PullRequestStatus_To_v1_PullRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_github_PullRequest_To_v1_PullRequest is an autogenerated conversion function.
+func Convert_github_PullRequest_To_v1_PullRequest(in *github.PullRequest, out *PullRequest, s conversion.Scope) error {
+	return autoConvert_github_PullRequest_To_v1_PullRequest(in, out, s)
+}
+
+func autoConvert_v1_PullRequestList_To_github_PullRequestList(in *PullRequestList, out *github.PullRequestList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]github.PullRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_PullRequestList_To_github_PullRequestList is an autogenerated conversion function.
+func Convert_v1_PullRequestList_To_github_PullRequestList(in *PullRequestList, out *github.PullRequestList, s conversion.Scope) error {
+	return autoConvert_v1_PullRequestList_To_github_PullRequestList(in, out, s)
+}
+
+func autoConvert_github_PullRequestList_To_v1_PullRequestList(in *github.PullRequestList, out *PullRequestList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]PullRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_github_PullRequestList_To_v1_PullRequestList is an autogenerated conversion function.
+func Convert_github_PullRequestList_To_v1_PullRequestList(in *github.PullRequestList, out *PullRequestList, s conversion.Scope) error {
+	return autoConvert_github_PullRequestList_To_v1_PullRequestList(in, out, s)
+}
+
+func autoConvert_v1_PullRequestSpec_To_github_PullRequestSpec(in *PullRequestSpec, out *github.PullRequestSpec, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Head = in.Head
+	out.Base = in.Base
+	out.Title = in.Title
+	out.Body = in.Body
+	out.Draft = in.Draft
+	out.Reviewers = *(*[]string)(unsafe.Pointer(&in.Reviewers))
+	out.Labels = *(*[]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_v1_PullRequestSpec_To_github_PullRequestSpec is an autogenerated conversion function.
+func Convert_v1_PullRequestSpec_To_github_PullRequestSpec(in *PullRequestSpec, out *github.PullRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1_PullRequestSpec_To_github_PullRequestSpec(in, out, s)
+}
+
+func autoConvert_github_PullRequestSpec_To_v1_PullRequestSpec(in *github.PullRequestSpec, out *PullRequestSpec, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Head = in.Head
+	out.Base = in.Base
+	out.Title = in.Title
+	out.Body = in.Body
+	out.Draft = in.Draft
+	out.Reviewers = *(*[]string)(unsafe.Pointer(&in.Reviewers))
+	out.Labels = *(*[]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_github_PullRequestSpec_To_v1_PullRequestSpec is an autogenerated conversion function.
+func Convert_github_PullRequestSpec_To_v1_PullRequestSpec(in *github.PullRequestSpec, out *PullRequestSpec, s conversion.Scope) error {
+	return autoConvert_github_PullRequestSpec_To_v1_PullRequestSpec(in, out, s)
+}
+
+func autoConvert_v1_PullRequestStatus_To_github_PullRequestStatus(in *PullRequestStatus, out *github.PullRequestStatus, s conversion.Scope) error {
+	out.Number = in.Number
+	out.URL = in.URL
+	out.State = in.State
+	out.MergeableState = in.MergeableState
+	out.ReviewDecision = in.ReviewDecision
+	out.Merged = in.Merged
+	return nil
+}
+
+// Convert_v1_PullRequestStatus_To_github_PullRequestStatus is an autogenerated conversion function.
+func Convert_v1_PullRequestStatus_To_github_PullRequestStatus(in *PullRequestStatus, out *github.PullRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1_PullRequestStatus_To_github_PullRequestStatus(in, out, s)
+}
+
+func autoConvert_github_PullRequestStatus_To_v1_PullRequestStatus(in *github.PullRequestStatus, out *PullRequestStatus, s conversion.Scope) error {
+	out.Number = in.Number
+	out.URL = in.URL
+	out.State = in.State
+	out.MergeableState = in.MergeableState
+	out.ReviewDecision = in.ReviewDecision
+	out.Merged = in.Merged
+	return nil
+}
+
+// Convert_github_PullRequestStatus_To_v1_PullRequestStatus is an autogenerated conversion function.
+func Convert_github_PullRequestStatus_To_v1_PullRequestStatus(in *github.PullRequestStatus, out *PullRequestStatus, s conversion.Scope) error {
+	return autoConvert_github_PullRequestStatus_To_v1_PullRequestStatus(in, out, s)
+}
+
+func autoConvert_v1_RepositoryFile_To_github_RepositoryFile(in *RepositoryFile, out *github.RepositoryFile, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_RepositoryFileSpec_To_github_RepositoryFileSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_RepositoryFileStatus_To_github_RepositoryFileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_RepositoryFile_To_github_RepositoryFile is an autogenerated conversion function.
+func Convert_v1_RepositoryFile_To_github_RepositoryFile(in *RepositoryFile, out *github.RepositoryFile, s conversion.Scope) error {
+	return autoConvert_v1_RepositoryFile_To_github_RepositoryFile(in, out, s)
+}
+
+func autoConvert_github_RepositoryFile_To_v1_RepositoryFile(in *github.RepositoryFile, out *RepositoryFile, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_github_RepositoryFileSpec_To_v1_RepositoryFileSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_github_RepositoryFileStatus_To_v1_RepositoryFileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_github_RepositoryFile_To_v1_RepositoryFile is an autogenerated conversion function.
+func Convert_github_RepositoryFile_To_v1_RepositoryFile(in *github.RepositoryFile, out *RepositoryFile, s conversion.Scope) error {
+	return autoConvert_github_RepositoryFile_To_v1_RepositoryFile(in, out, s)
+}
+
+func autoConvert_v1_RepositoryFileList_To_github_RepositoryFileList(in *RepositoryFileList, out *github.RepositoryFileList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]github.RepositoryFile)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_RepositoryFileList_To_github_RepositoryFileList is an autogenerated conversion function.
+func Convert_v1_RepositoryFileList_To_github_RepositoryFileList(in *RepositoryFileList, out *github.RepositoryFileList, s conversion.Scope) error {
+	return autoConvert_v1_RepositoryFileList_To_github_RepositoryFileList(in, out, s)
+}
+
+func autoConvert_github_RepositoryFileList_To_v1_RepositoryFileList(in *github.RepositoryFileList, out *RepositoryFileList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]RepositoryFile)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_github_RepositoryFileList_To_v1_RepositoryFileList is an autogenerated conversion function.
+func Convert_github_RepositoryFileList_To_v1_RepositoryFileList(in *github.RepositoryFileList, out *RepositoryFileList, s conversion.Scope) error {
+	return autoConvert_github_RepositoryFileList_To_v1_RepositoryFileList(in, out, s)
+}
+
+func autoConvert_v1_RepositoryFileSpec_To_github_RepositoryFileSpec(in *RepositoryFileSpec, out *github.RepositoryFileSpec, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Path = in.Path
+	out.Branch = in.Branch
+	out.Content = in.Content
+	out.ConfigMapName = in.ConfigMapName
+	out.ConfigMapKey = in.ConfigMapKey
+	out.CommitMessage = in.CommitMessage
+	out.AuthorName = in.AuthorName
+	out.AuthorEmail = in.AuthorEmail
+	out.PullRequest = in.PullRequest
+	out.HeadBranch = in.HeadBranch
+	return nil
+}
+
+// Convert_v1_RepositoryFileSpec_To_github_RepositoryFileSpec is an autogenerated conversion function.
+func Convert_v1_RepositoryFileSpec_To_github_RepositoryFileSpec(in *RepositoryFileSpec, out *github.RepositoryFileSpec, s conversion.Scope) error {
+	return autoConvert_v1_RepositoryFileSpec_To_github_RepositoryFileSpec(in, out, s)
+}
+
+func autoConvert_github_RepositoryFileSpec_To_v1_RepositoryFileSpec(in *github.RepositoryFileSpec, out *RepositoryFileSpec, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Path = in.Path
+	out.Branch = in.Branch
+	out.Content = in.Content
+	out.ConfigMapName = in.ConfigMapName
+	out.ConfigMapKey = in.ConfigMapKey
+	out.CommitMessage = in.CommitMessage
+	out.AuthorName = in.AuthorName
+	out.AuthorEmail = in.AuthorEmail
+	out.PullRequest = in.PullRequest
+	out.HeadBranch = in.HeadBranch
+	return nil
+}
+
+// Convert_github_RepositoryFileSpec_To_v1_RepositoryFileSpec is an autogenerated conversion function.
+func Convert_github_RepositoryFileSpec_To_v1_RepositoryFileSpec(in *github.RepositoryFileSpec, out *RepositoryFileSpec, s conversion.Scope) error {
+	return autoConvert_github_RepositoryFileSpec_To_v1_RepositoryFileSpec(in, out, s)
+}
+
+func autoConvert_v1_RepositoryFileStatus_To_github_RepositoryFileStatus(in *RepositoryFileStatus, out *github.RepositoryFileStatus, s conversion.Scope) error {
+	out.BlobSHA = in.BlobSHA
+	out.CommitSHA = in.CommitSHA
+	out.PullRequestNumber = in.PullRequestNumber
+	out.PullRequestURL = in.PullRequestURL
+	out.BaseBlobSHA = in.BaseBlobSHA
+	out.DriftedBlobSHA = in.DriftedBlobSHA
+	out.DriftDetectedAt = in.DriftDetectedAt
+	return nil
+}
+
+// Convert_v1_RepositoryFileStatus_To_github_RepositoryFileStatus is an autogenerated conversion function.
+func Convert_v1_RepositoryFileStatus_To_github_RepositoryFileStatus(in *RepositoryFileStatus, out *github.RepositoryFileStatus, s conversion.Scope) error {
+	return autoConvert_v1_RepositoryFileStatus_To_github_RepositoryFileStatus(in, out, s)
+}
+
+func autoConvert_github_RepositoryFileStatus_To_v1_RepositoryFileStatus(in *github.RepositoryFileStatus, out *RepositoryFileStatus, s conversion.Scope) error {
+	out.BlobSHA = in.BlobSHA
+	out.CommitSHA = in.CommitSHA
+	out.PullRequestNumber = in.PullRequestNumber
+	out.PullRequestURL = in.PullRequestURL
+	out.BaseBlobSHA = in.BaseBlobSHA
+	out.DriftedBlobSHA = in.DriftedBlobSHA
+	out.DriftDetectedAt = in.DriftDetectedAt
+	return nil
+}
+
+// Convert_github_RepositoryFileStatus_To_v1_RepositoryFileStatus is an autogenerated conversion function.
+func Convert_github_RepositoryFileStatus_To_v1_RepositoryFileStatus(in *github.RepositoryFileStatus, out *RepositoryFileStatus, s conversion.Scope) error {
+	return autoConvert_github_RepositoryFileStatus_To_v1_RepositoryFileStatus(in, out, s)
+}
+
+func autoConvert_v1_WorkflowTrigger_To_github_WorkflowTrigger(in *WorkflowTrigger, out *github.WorkflowTrigger, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_WorkflowTriggerSpec_To_github_WorkflowTriggerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_WorkflowTriggerStatus_To_github_WorkflowTriggerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_WorkflowTrigger_To_github_WorkflowTrigger is an autogenerated conversion function.
+func Convert_v1_WorkflowTrigger_To_github_WorkflowTrigger(in *WorkflowTrigger, out *github.WorkflowTrigger, s conversion.Scope) error {
+	return autoConvert_v1_WorkflowTrigger_To_github_WorkflowTrigger(in, out, s)
+}
+
+func autoConvert_github_WorkflowTrigger_To_v1_WorkflowTrigger(in *github.WorkflowTrigger, out *WorkflowTrigger, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_github_WorkflowTriggerSpec_To_v1_WorkflowTriggerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_github_WorkflowTriggerStatus_To_v1_WorkflowTriggerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_github_WorkflowTrigger_To_v1_WorkflowTrigger is an autogenerated conversion function.
+func Convert_github_WorkflowTrigger_To_v1_WorkflowTrigger(in *github.WorkflowTrigger, out *WorkflowTrigger, s conversion.Scope) error {
+	return autoConvert_github_WorkflowTrigger_To_v1_WorkflowTrigger(in, out, s)
+}
+
+func autoConvert_v1_WorkflowTriggerCondition_To_github_WorkflowTriggerCondition(in *WorkflowTriggerCondition, out *github.WorkflowTriggerCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_WorkflowTriggerCondition_To_github_WorkflowTriggerCondition is an autogenerated conversion function.
+func Convert_v1_WorkflowTriggerCondition_To_github_WorkflowTriggerCondition(in *WorkflowTriggerCondition, out *github.WorkflowTriggerCondition, s conversion.Scope) error {
+	return autoConvert_v1_WorkflowTriggerCondition_To_github_WorkflowTriggerCondition(in, out, s)
+}
+
+func autoConvert_github_WorkflowTriggerCondition_To_v1_WorkflowTriggerCondition(in *github.WorkflowTriggerCondition, out *WorkflowTriggerCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_github_WorkflowTriggerCondition_To_v1_WorkflowTriggerCondition is an autogenerated conversion function.
+func Convert_github_WorkflowTriggerCondition_To_v1_WorkflowTriggerCondition(in *github.WorkflowTriggerCondition, out *WorkflowTriggerCondition, s conversion.Scope) error {
+	return autoConvert_github_WorkflowTriggerCondition_To_v1_WorkflowTriggerCondition(in, out, s)
+}
+
+func autoConvert_v1_WorkflowTriggerList_To_github_WorkflowTriggerList(in *WorkflowTriggerList, out *github.WorkflowTriggerList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]github.WorkflowTrigger)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_WorkflowTriggerList_To_github_WorkflowTriggerList is an autogenerated conversion function.
+func Convert_v1_WorkflowTriggerList_To_github_WorkflowTriggerList(in *WorkflowTriggerList, out *github.WorkflowTriggerList, s conversion.Scope) error {
+	return autoConvert_v1_WorkflowTriggerList_To_github_WorkflowTriggerList(in, out, s)
+}
+
+func autoConvert_github_WorkflowTriggerList_To_v1_WorkflowTriggerList(in *github.WorkflowTriggerList, out *WorkflowTriggerList, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]WorkflowTrigger)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_github_WorkflowTriggerList_To_v1_WorkflowTriggerList is an autogenerated conversion function.
+func Convert_github_WorkflowTriggerList_To_v1_WorkflowTriggerList(in *github.WorkflowTriggerList, out *WorkflowTriggerList, s conversion.Scope) error {
+	return autoConvert_github_WorkflowTriggerList_To_v1_WorkflowTriggerList(in, out, s)
+}
+
+func autoConvert_v1_WorkflowTriggerSpec_To_github_WorkflowTriggerSpec(in *WorkflowTriggerSpec, out *github.WorkflowTriggerSpec, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Workflow = in.Workflow
+	out.Ref = in.Ref
+	out.Inputs = *(*map[string]string)(unsafe.Pointer(&in.Inputs))
+	out.EventType = in.EventType
+	out.ClientPayload = *(*map[string]string)(unsafe.Pointer(&in.ClientPayload))
+	return nil
+}
+
+// Convert_v1_WorkflowTriggerSpec_To_github_WorkflowTriggerSpec is an autogenerated conversion function.
+func Convert_v1_WorkflowTriggerSpec_To_github_WorkflowTriggerSpec(in *WorkflowTriggerSpec, out *github.WorkflowTriggerSpec, s conversion.Scope) error {
+	return autoConvert_v1_WorkflowTriggerSpec_To_github_WorkflowTriggerSpec(in, out, s)
+}
+
+func autoConvert_github_WorkflowTriggerSpec_To_v1_WorkflowTriggerSpec(in *github.WorkflowTriggerSpec, out *WorkflowTriggerSpec, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.Repository = in.Repository
+	out.Workflow = in.Workflow
+	out.Ref = in.Ref
+	out.Inputs = *(*map[string]string)(unsafe.Pointer(&in.Inputs))
+	out.EventType = in.EventType
+	out.ClientPayload = *(*map[string]string)(unsafe.Pointer(&in.ClientPayload))
+	return nil
+}
+
+// Convert_github_WorkflowTriggerSpec_To_v1_WorkflowTriggerSpec is an autogenerated conversion function.
+func Convert_github_WorkflowTriggerSpec_To_v1_WorkflowTriggerSpec(in *github.WorkflowTriggerSpec, out *WorkflowTriggerSpec, s conversion.Scope) error {
+	return autoConvert_github_WorkflowTriggerSpec_To_v1_WorkflowTriggerSpec(in, out, s)
+}
+
+func autoConvert_v1_WorkflowTriggerStatus_To_github_WorkflowTriggerStatus(in *WorkflowTriggerStatus, out *github.WorkflowTriggerStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Dispatched = in.Dispatched
+	out.DispatchedAt = in.DispatchedAt
+	out.HeadSHA = in.HeadSHA
+	out.RunID = in.RunID
+	out.RunURL = in.RunURL
+	out.RunStatus = in.RunStatus
+	out.Conclusion = in.Conclusion
+	out.Conditions = *(*[]github.WorkflowTriggerCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1_WorkflowTriggerStatus_To_github_WorkflowTriggerStatus is an autogenerated conversion function.
+func Convert_v1_WorkflowTriggerStatus_To_github_WorkflowTriggerStatus(in *WorkflowTriggerStatus, out *github.WorkflowTriggerStatus, s conversion.Scope) error {
+	return autoConvert_v1_WorkflowTriggerStatus_To_github_WorkflowTriggerStatus(in, out, s)
+}
+
+func autoConvert_github_WorkflowTriggerStatus_To_v1_WorkflowTriggerStatus(in *github.WorkflowTriggerStatus, out *WorkflowTriggerStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Dispatched = in.Dispatched
+	out.DispatchedAt = in.DispatchedAt
+	out.HeadSHA = in.HeadSHA
+	out.RunID = in.RunID
+	out.RunURL = in.RunURL
+	out.RunStatus = in.RunStatus
+	out.Conclusion = in.Conclusion
+	out.Conditions = *(*[]WorkflowTriggerCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_github_WorkflowTriggerStatus_To_v1_WorkflowTriggerStatus is an autogenerated conversion function.
+func Convert_github_WorkflowTriggerStatus_To_v1_WorkflowTriggerStatus(in *github.WorkflowTriggerStatus, out *WorkflowTriggerStatus, s conversion.Scope) error {
+	return autoConvert_github_WorkflowTriggerStatus_To_v1_WorkflowTriggerStatus(in, out, s)
+}
@@ -36,10 +36,58 @@ func init() {
 // Deprecated: deepcopy registration will go away when static deepcopy is fully implemented.
 func RegisterDeepCopies(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedDeepCopyFuncs(
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ActionsSecret).DeepCopyInto(out.(*ActionsSecret))
+			return nil
+		}, InType: reflect.TypeOf(&ActionsSecret{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ActionsSecretKey).DeepCopyInto(out.(*ActionsSecretKey))
+			return nil
+		}, InType: reflect.TypeOf(&ActionsSecretKey{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ActionsSecretList).DeepCopyInto(out.(*ActionsSecretList))
+			return nil
+		}, InType: reflect.TypeOf(&ActionsSecretList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ActionsSecretSpec).DeepCopyInto(out.(*ActionsSecretSpec))
+			return nil
+		}, InType: reflect.TypeOf(&ActionsSecretSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ActionsSecretStatus).DeepCopyInto(out.(*ActionsSecretStatus))
+			return nil
+		}, InType: reflect.TypeOf(&ActionsSecretStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterComment).DeepCopyInto(out.(*ClusterComment))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterComment{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterCommentList).DeepCopyInto(out.(*ClusterCommentList))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterCommentList{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*Comment).DeepCopyInto(out.(*Comment))
 			return nil
 		}, InType: reflect.TypeOf(&Comment{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*CommentCampaign).DeepCopyInto(out.(*CommentCampaign))
+			return nil
+		}, InType: reflect.TypeOf(&CommentCampaign{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*CommentCampaignList).DeepCopyInto(out.(*CommentCampaignList))
+			return nil
+		}, InType: reflect.TypeOf(&CommentCampaignList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*CommentCampaignSpec).DeepCopyInto(out.(*CommentCampaignSpec))
+			return nil
+		}, InType: reflect.TypeOf(&CommentCampaignSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*CommentCampaignStatus).DeepCopyInto(out.(*CommentCampaignStatus))
+			return nil
+		}, InType: reflect.TypeOf(&CommentCampaignStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*CommentCampaignTarget).DeepCopyInto(out.(*CommentCampaignTarget))
+			return nil
+		}, InType: reflect.TypeOf(&CommentCampaignTarget{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*CommentList).DeepCopyInto(out.(*CommentList))
 			return nil
@@ -52,16 +100,302 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*CommentStatus).DeepCopyInto(out.(*CommentStatus))
 			return nil
 		}, InType: reflect.TypeOf(&CommentStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*Discussion).DeepCopyInto(out.(*Discussion))
+			return nil
+		}, InType: reflect.TypeOf(&Discussion{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*DiscussionList).DeepCopyInto(out.(*DiscussionList))
+			return nil
+		}, InType: reflect.TypeOf(&DiscussionList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*DiscussionSpec).DeepCopyInto(out.(*DiscussionSpec))
+			return nil
+		}, InType: reflect.TypeOf(&DiscussionSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*DiscussionStatus).DeepCopyInto(out.(*DiscussionStatus))
+			return nil
+		}, InType: reflect.TypeOf(&DiscussionStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*IssueReference).DeepCopyInto(out.(*IssueReference))
+			return nil
+		}, InType: reflect.TypeOf(&IssueReference{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*NotificationRule).DeepCopyInto(out.(*NotificationRule))
+			return nil
+		}, InType: reflect.TypeOf(&NotificationRule{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*NotificationRuleList).DeepCopyInto(out.(*NotificationRuleList))
+			return nil
+		}, InType: reflect.TypeOf(&NotificationRuleList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*NotificationRuleSpec).DeepCopyInto(out.(*NotificationRuleSpec))
+			return nil
+		}, InType: reflect.TypeOf(&NotificationRuleSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*NotificationRuleStatus).DeepCopyInto(out.(*NotificationRuleStatus))
+			return nil
+		}, InType: reflect.TypeOf(&NotificationRuleStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*PullRequest).DeepCopyInto(out.(*PullRequest))
+			return nil
+		}, InType: reflect.TypeOf(&PullRequest{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*PullRequestList).DeepCopyInto(out.(*PullRequestList))
+			return nil
+		}, InType: reflect.TypeOf(&PullRequestList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*PullRequestSpec).DeepCopyInto(out.(*PullRequestSpec))
+			return nil
+		}, InType: reflect.TypeOf(&PullRequestSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*PullRequestStatus).DeepCopyInto(out.(*PullRequestStatus))
+			return nil
+		}, InType: reflect.TypeOf(&PullRequestStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*RepositoryFile).DeepCopyInto(out.(*RepositoryFile))
+			return nil
+		}, InType: reflect.TypeOf(&RepositoryFile{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*RepositoryFileList).DeepCopyInto(out.(*RepositoryFileList))
+			return nil
+		}, InType: reflect.TypeOf(&RepositoryFileList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*RepositoryFileSpec).DeepCopyInto(out.(*RepositoryFileSpec))
+			return nil
+		}, InType: reflect.TypeOf(&RepositoryFileSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*RepositoryFileStatus).DeepCopyInto(out.(*RepositoryFileStatus))
+			return nil
+		}, InType: reflect.TypeOf(&RepositoryFileStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*WorkflowTrigger).DeepCopyInto(out.(*WorkflowTrigger))
+			return nil
+		}, InType: reflect.TypeOf(&WorkflowTrigger{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*WorkflowTriggerCondition).DeepCopyInto(out.(*WorkflowTriggerCondition))
+			return nil
+		}, InType: reflect.TypeOf(&WorkflowTriggerCondition{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*WorkflowTriggerList).DeepCopyInto(out.(*WorkflowTriggerList))
+			return nil
+		}, InType: reflect.TypeOf(&WorkflowTriggerList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*WorkflowTriggerSpec).DeepCopyInto(out.(*WorkflowTriggerSpec))
+			return nil
+		}, InType: reflect.TypeOf(&WorkflowTriggerSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*WorkflowTriggerStatus).DeepCopyInto(out.(*WorkflowTriggerStatus))
+			return nil
+		}, InType: reflect.TypeOf(&WorkflowTriggerStatus{})},
 	)
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionsSecret) DeepCopyInto(out *ActionsSecret) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsSecret.
+func (in *ActionsSecret) DeepCopy() *ActionsSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(ActionsSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ActionsSecret) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionsSecretKey) DeepCopyInto(out *ActionsSecretKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsSecretKey.
+func (in *ActionsSecretKey) DeepCopy() *ActionsSecretKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ActionsSecretKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionsSecretList) DeepCopyInto(out *ActionsSecretList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ActionsSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsSecretList.
+func (in *ActionsSecretList) DeepCopy() *ActionsSecretList {
+	if in == nil {
+		return nil
+	}
+	out := new(ActionsSecretList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ActionsSecretList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionsSecretSpec) DeepCopyInto(out *ActionsSecretSpec) {
+	*out = *in
+	if in.SecretKeys != nil {
+		in, out := &in.SecretKeys, &out.SecretKeys
+		*out = make([]ActionsSecretKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfigMapKeys != nil {
+		in, out := &in.ConfigMapKeys, &out.ConfigMapKeys
+		*out = make([]ActionsSecretKey, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsSecretSpec.
+func (in *ActionsSecretSpec) DeepCopy() *ActionsSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ActionsSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionsSecretStatus) DeepCopyInto(out *ActionsSecretStatus) {
+	*out = *in
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsSecretStatus.
+func (in *ActionsSecretStatus) DeepCopy() *ActionsSecretStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ActionsSecretStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterComment) DeepCopyInto(out *ClusterComment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComment.
+func (in *ClusterComment) DeepCopy() *ClusterComment {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterComment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterComment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCommentList) DeepCopyInto(out *ClusterCommentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterComment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCommentList.
+func (in *ClusterCommentList) DeepCopy() *ClusterCommentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCommentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterCommentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Comment) DeepCopyInto(out *Comment) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -85,13 +419,42 @@ func (in *Comment) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommentList) DeepCopyInto(out *CommentList) {
+func (in *CommentCampaign) DeepCopyInto(out *CommentCampaign) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentCampaign.
+func (in *CommentCampaign) DeepCopy() *CommentCampaign {
+	if in == nil {
+		return nil
+	}
+	out := new(CommentCampaign)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CommentCampaign) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommentCampaignList) DeepCopyInto(out *CommentCampaignList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Comment, len(*in))
+		*out = make([]CommentCampaign, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -99,18 +462,18 @@ func (in *CommentList) DeepCopyInto(out *CommentList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentList.
-func (in *CommentList) DeepCopy() *CommentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentCampaignList.
+func (in *CommentCampaignList) DeepCopy() *CommentCampaignList {
 	if in == nil {
 		return nil
 	}
-	out := new(CommentList)
+	out := new(CommentCampaignList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CommentList) DeepCopyObject() runtime.Object {
+func (in *CommentCampaignList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	} else {
@@ -119,33 +482,675 @@ func (in *CommentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommentSpec) DeepCopyInto(out *CommentSpec) {
+func (in *CommentCampaignSpec) DeepCopyInto(out *CommentCampaignSpec) {
 	*out = *in
+	if in.Issues != nil {
+		in, out := &in.Issues, &out.Issues
+		*out = make([]IssueReference, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentSpec.
-func (in *CommentSpec) DeepCopy() *CommentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentCampaignSpec.
+func (in *CommentCampaignSpec) DeepCopy() *CommentCampaignSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CommentSpec)
+	out := new(CommentCampaignSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommentStatus) DeepCopyInto(out *CommentStatus) {
+func (in *CommentCampaignStatus) DeepCopyInto(out *CommentCampaignStatus) {
 	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]CommentCampaignTarget, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentStatus.
-func (in *CommentStatus) DeepCopy() *CommentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentCampaignStatus.
+func (in *CommentCampaignStatus) DeepCopy() *CommentCampaignStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CommentStatus)
+	out := new(CommentCampaignStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommentCampaignTarget) DeepCopyInto(out *CommentCampaignTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentCampaignTarget.
+func (in *CommentCampaignTarget) DeepCopy() *CommentCampaignTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(CommentCampaignTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommentList) DeepCopyInto(out *CommentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Comment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentList.
+func (in *CommentList) DeepCopy() *CommentList {
+	if in == nil {
+		return nil
+	}
+	out := new(CommentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CommentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommentSpec) DeepCopyInto(out *CommentSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentSpec.
+func (in *CommentSpec) DeepCopy() *CommentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CommentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommentStatus) DeepCopyInto(out *CommentStatus) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentStatus.
+func (in *CommentStatus) DeepCopy() *CommentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CommentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Discussion) DeepCopyInto(out *Discussion) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Discussion.
+func (in *Discussion) DeepCopy() *Discussion {
+	if in == nil {
+		return nil
+	}
+	out := new(Discussion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Discussion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscussionList) DeepCopyInto(out *DiscussionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Discussion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscussionList.
+func (in *DiscussionList) DeepCopy() *DiscussionList {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscussionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiscussionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscussionSpec) DeepCopyInto(out *DiscussionSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscussionSpec.
+func (in *DiscussionSpec) DeepCopy() *DiscussionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscussionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscussionStatus) DeepCopyInto(out *DiscussionStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscussionStatus.
+func (in *DiscussionStatus) DeepCopy() *DiscussionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscussionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueReference) DeepCopyInto(out *IssueReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueReference.
+func (in *IssueReference) DeepCopy() *IssueReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRule) DeepCopyInto(out *NotificationRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRule.
+func (in *NotificationRule) DeepCopy() *NotificationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRuleList) DeepCopyInto(out *NotificationRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRuleList.
+func (in *NotificationRuleList) DeepCopy() *NotificationRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRuleSpec) DeepCopyInto(out *NotificationRuleSpec) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Target = in.Target
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRuleSpec.
+func (in *NotificationRuleSpec) DeepCopy() *NotificationRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRuleStatus) DeepCopyInto(out *NotificationRuleStatus) {
+	*out = *in
+	in.LastFiredAt.DeepCopyInto(&out.LastFiredAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRuleStatus.
+func (in *NotificationRuleStatus) DeepCopy() *NotificationRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequest) DeepCopyInto(out *PullRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequest.
+func (in *PullRequest) DeepCopy() *PullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PullRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestList) DeepCopyInto(out *PullRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PullRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestList.
+func (in *PullRequestList) DeepCopy() *PullRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PullRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestSpec) DeepCopyInto(out *PullRequestSpec) {
+	*out = *in
+	if in.Reviewers != nil {
+		in, out := &in.Reviewers, &out.Reviewers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestSpec.
+func (in *PullRequestSpec) DeepCopy() *PullRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestStatus) DeepCopyInto(out *PullRequestStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestStatus.
+func (in *PullRequestStatus) DeepCopy() *PullRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFile) DeepCopyInto(out *RepositoryFile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFile.
+func (in *RepositoryFile) DeepCopy() *RepositoryFile {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryFile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFileList) DeepCopyInto(out *RepositoryFileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RepositoryFile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFileList.
+func (in *RepositoryFileList) DeepCopy() *RepositoryFileList {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryFileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryFileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFileSpec) DeepCopyInto(out *RepositoryFileSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFileSpec.
+func (in *RepositoryFileSpec) DeepCopy() *RepositoryFileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryFileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFileStatus) DeepCopyInto(out *RepositoryFileStatus) {
+	*out = *in
+	in.DriftDetectedAt.DeepCopyInto(&out.DriftDetectedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFileStatus.
+func (in *RepositoryFileStatus) DeepCopy() *RepositoryFileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryFileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTrigger) DeepCopyInto(out *WorkflowTrigger) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTrigger.
+func (in *WorkflowTrigger) DeepCopy() *WorkflowTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowTrigger) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTriggerCondition) DeepCopyInto(out *WorkflowTriggerCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTriggerCondition.
+func (in *WorkflowTriggerCondition) DeepCopy() *WorkflowTriggerCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTriggerCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTriggerList) DeepCopyInto(out *WorkflowTriggerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkflowTrigger, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTriggerList.
+func (in *WorkflowTriggerList) DeepCopy() *WorkflowTriggerList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTriggerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowTriggerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTriggerSpec) DeepCopyInto(out *WorkflowTriggerSpec) {
+	*out = *in
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ClientPayload != nil {
+		in, out := &in.ClientPayload, &out.ClientPayload
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTriggerSpec.
+func (in *WorkflowTriggerSpec) DeepCopy() *WorkflowTriggerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTriggerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTriggerStatus) DeepCopyInto(out *WorkflowTriggerStatus) {
+	*out = *in
+	in.DispatchedAt.DeepCopyInto(&out.DispatchedAt)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]WorkflowTriggerCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTriggerStatus.
+func (in *WorkflowTriggerStatus) DeepCopy() *WorkflowTriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTriggerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
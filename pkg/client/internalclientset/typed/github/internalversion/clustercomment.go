@@ -0,0 +1,161 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internalversion
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	scheme "github.com/nikhita/kube-custom-controller/pkg/client/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterCommentsGetter has a method to return a ClusterCommentInterface.
+// A group's client should implement this interface.
+type ClusterCommentsGetter interface {
+	ClusterComments() ClusterCommentInterface
+}
+
+// ClusterCommentInterface has methods to work with ClusterComment resources.
+type ClusterCommentInterface interface {
+	Create(*github.ClusterComment) (*github.ClusterComment, error)
+	Update(*github.ClusterComment) (*github.ClusterComment, error)
+	UpdateStatus(*github.ClusterComment) (*github.ClusterComment, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*github.ClusterComment, error)
+	List(opts v1.ListOptions) (*github.ClusterCommentList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.ClusterComment, err error)
+	ClusterCommentExpansion
+}
+
+// clusterComments implements ClusterCommentInterface
+type clusterComments struct {
+	client rest.Interface
+}
+
+// newClusterComments returns a ClusterComments
+func newClusterComments(c *GithubClient) *clusterComments {
+	return &clusterComments{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterComment, and returns the corresponding clusterComment object, and an error if there is any.
+func (c *clusterComments) Get(name string, options v1.GetOptions) (result *github.ClusterComment, err error) {
+	result = &github.ClusterComment{}
+	err = c.client.Get().
+		Resource("clustercomments").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterComments that match those selectors.
+func (c *clusterComments) List(opts v1.ListOptions) (result *github.ClusterCommentList, err error) {
+	result = &github.ClusterCommentList{}
+	err = c.client.Get().
+		Resource("clustercomments").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterComments.
+func (c *clusterComments) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clustercomments").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Watch()
+}
+
+// Create takes the representation of a clusterComment and creates it.  Returns the server's representation of the clusterComment, and an error, if there is any.
+func (c *clusterComments) Create(clusterComment *github.ClusterComment) (result *github.ClusterComment, err error) {
+	result = &github.ClusterComment{}
+	err = c.client.Post().
+		Resource("clustercomments").
+		Body(clusterComment).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterComment and updates it. Returns the server's representation of the clusterComment, and an error, if there is any.
+func (c *clusterComments) Update(clusterComment *github.ClusterComment) (result *github.ClusterComment, err error) {
+	result = &github.ClusterComment{}
+	err = c.client.Put().
+		Resource("clustercomments").
+		Name(clusterComment.Name).
+		Body(clusterComment).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *clusterComments) UpdateStatus(clusterComment *github.ClusterComment) (result *github.ClusterComment, err error) {
+	result = &github.ClusterComment{}
+	err = c.client.Put().
+		Resource("clustercomments").
+		Name(clusterComment.Name).
+		SubResource("status").
+		Body(clusterComment).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterComment and deletes it. Returns an error if one occurs.
+func (c *clusterComments) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clustercomments").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterComments) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	return c.client.Delete().
+		Resource("clustercomments").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterComment.
+func (c *clusterComments) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.ClusterComment, err error) {
+	result = &github.ClusterComment{}
+	err = c.client.Patch(pt).
+		Resource("clustercomments").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
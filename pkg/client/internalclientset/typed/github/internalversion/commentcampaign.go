@@ -0,0 +1,172 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internalversion
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	scheme "github.com/nikhita/kube-custom-controller/pkg/client/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// CommentCampaignsGetter has a method to return a CommentCampaignInterface.
+// A group's client should implement this interface.
+type CommentCampaignsGetter interface {
+	CommentCampaigns(namespace string) CommentCampaignInterface
+}
+
+// CommentCampaignInterface has methods to work with CommentCampaign resources.
+type CommentCampaignInterface interface {
+	Create(*github.CommentCampaign) (*github.CommentCampaign, error)
+	Update(*github.CommentCampaign) (*github.CommentCampaign, error)
+	UpdateStatus(*github.CommentCampaign) (*github.CommentCampaign, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*github.CommentCampaign, error)
+	List(opts v1.ListOptions) (*github.CommentCampaignList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.CommentCampaign, err error)
+	CommentCampaignExpansion
+}
+
+// commentCampaigns implements CommentCampaignInterface
+type commentCampaigns struct {
+	client rest.Interface
+	ns     string
+}
+
+// newCommentCampaigns returns a CommentCampaigns
+func newCommentCampaigns(c *GithubClient, namespace string) *commentCampaigns {
+	return &commentCampaigns{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the commentCampaign, and returns the corresponding commentCampaign object, and an error if there is any.
+func (c *commentCampaigns) Get(name string, options v1.GetOptions) (result *github.CommentCampaign, err error) {
+	result = &github.CommentCampaign{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of CommentCampaigns that match those selectors.
+func (c *commentCampaigns) List(opts v1.ListOptions) (result *github.CommentCampaignList, err error) {
+	result = &github.CommentCampaignList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested commentCampaigns.
+func (c *commentCampaigns) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Watch()
+}
+
+// Create takes the representation of a commentCampaign and creates it.  Returns the server's representation of the commentCampaign, and an error, if there is any.
+func (c *commentCampaigns) Create(commentCampaign *github.CommentCampaign) (result *github.CommentCampaign, err error) {
+	result = &github.CommentCampaign{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		Body(commentCampaign).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a commentCampaign and updates it. Returns the server's representation of the commentCampaign, and an error, if there is any.
+func (c *commentCampaigns) Update(commentCampaign *github.CommentCampaign) (result *github.CommentCampaign, err error) {
+	result = &github.CommentCampaign{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		Name(commentCampaign.Name).
+		Body(commentCampaign).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *commentCampaigns) UpdateStatus(commentCampaign *github.CommentCampaign) (result *github.CommentCampaign, err error) {
+	result = &github.CommentCampaign{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		Name(commentCampaign.Name).
+		SubResource("status").
+		Body(commentCampaign).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the commentCampaign and deletes it. Returns an error if one occurs.
+func (c *commentCampaigns) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *commentCampaigns) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched commentCampaign.
+func (c *commentCampaigns) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.CommentCampaign, err error) {
+	result = &github.CommentCampaign{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internalversion
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	scheme "github.com/nikhita/kube-custom-controller/pkg/client/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// DiscussionsGetter has a method to return a DiscussionInterface.
+// A group's client should implement this interface.
+type DiscussionsGetter interface {
+	Discussions(namespace string) DiscussionInterface
+}
+
+// DiscussionInterface has methods to work with Discussion resources.
+type DiscussionInterface interface {
+	Create(*github.Discussion) (*github.Discussion, error)
+	Update(*github.Discussion) (*github.Discussion, error)
+	UpdateStatus(*github.Discussion) (*github.Discussion, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*github.Discussion, error)
+	List(opts v1.ListOptions) (*github.DiscussionList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.Discussion, err error)
+	DiscussionExpansion
+}
+
+// discussions implements DiscussionInterface
+type discussions struct {
+	client rest.Interface
+	ns     string
+}
+
+// newDiscussions returns a Discussions
+func newDiscussions(c *GithubClient, namespace string) *discussions {
+	return &discussions{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the discussion, and returns the corresponding discussion object, and an error if there is any.
+func (c *discussions) Get(name string, options v1.GetOptions) (result *github.Discussion, err error) {
+	result = &github.Discussion{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("discussions").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Discussions that match those selectors.
+func (c *discussions) List(opts v1.ListOptions) (result *github.DiscussionList, err error) {
+	result = &github.DiscussionList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("discussions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested discussions.
+func (c *discussions) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("discussions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Watch()
+}
+
+// Create takes the representation of a discussion and creates it.  Returns the server's representation of the discussion, and an error, if there is any.
+func (c *discussions) Create(discussion *github.Discussion) (result *github.Discussion, err error) {
+	result = &github.Discussion{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("discussions").
+		Body(discussion).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a discussion and updates it. Returns the server's representation of the discussion, and an error, if there is any.
+func (c *discussions) Update(discussion *github.Discussion) (result *github.Discussion, err error) {
+	result = &github.Discussion{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("discussions").
+		Name(discussion.Name).
+		Body(discussion).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *discussions) UpdateStatus(discussion *github.Discussion) (result *github.Discussion, err error) {
+	result = &github.Discussion{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("discussions").
+		Name(discussion.Name).
+		SubResource("status").
+		Body(discussion).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the discussion and deletes it. Returns an error if one occurs.
+func (c *discussions) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("discussions").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *discussions) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("discussions").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched discussion.
+func (c *discussions) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.Discussion, err error) {
+	result = &github.Discussion{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("discussions").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
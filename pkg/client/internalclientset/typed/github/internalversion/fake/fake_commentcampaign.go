@@ -0,0 +1,138 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeCommentCampaigns implements CommentCampaignInterface
+type FakeCommentCampaigns struct {
+	Fake *FakeGithub
+	ns   string
+}
+
+var commentcampaignsResource = schema.GroupVersionResource{Group: "github", Version: "", Resource: "commentcampaigns"}
+
+var commentcampaignsKind = schema.GroupVersionKind{Group: "github", Version: "", Kind: "CommentCampaign"}
+
+// Get takes name of the commentCampaign, and returns the corresponding commentCampaign object, and an error if there is any.
+func (c *FakeCommentCampaigns) Get(name string, options v1.GetOptions) (result *github.CommentCampaign, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(commentcampaignsResource, c.ns, name), &github.CommentCampaign{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.CommentCampaign), err
+}
+
+// List takes label and field selectors, and returns the list of CommentCampaigns that match those selectors.
+func (c *FakeCommentCampaigns) List(opts v1.ListOptions) (result *github.CommentCampaignList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(commentcampaignsResource, commentcampaignsKind, c.ns, opts), &github.CommentCampaignList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &github.CommentCampaignList{}
+	for _, item := range obj.(*github.CommentCampaignList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested commentCampaigns.
+func (c *FakeCommentCampaigns) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(commentcampaignsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a commentCampaign and creates it.  Returns the server's representation of the commentCampaign, and an error, if there is any.
+func (c *FakeCommentCampaigns) Create(commentCampaign *github.CommentCampaign) (result *github.CommentCampaign, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(commentcampaignsResource, c.ns, commentCampaign), &github.CommentCampaign{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.CommentCampaign), err
+}
+
+// Update takes the representation of a commentCampaign and updates it. Returns the server's representation of the commentCampaign, and an error, if there is any.
+func (c *FakeCommentCampaigns) Update(commentCampaign *github.CommentCampaign) (result *github.CommentCampaign, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(commentcampaignsResource, c.ns, commentCampaign), &github.CommentCampaign{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.CommentCampaign), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeCommentCampaigns) UpdateStatus(commentCampaign *github.CommentCampaign) (*github.CommentCampaign, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(commentcampaignsResource, "status", c.ns, commentCampaign), &github.CommentCampaign{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.CommentCampaign), err
+}
+
+// Delete takes name of the commentCampaign and deletes it. Returns an error if one occurs.
+func (c *FakeCommentCampaigns) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(commentcampaignsResource, c.ns, name), &github.CommentCampaign{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeCommentCampaigns) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(commentcampaignsResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &github.CommentCampaignList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched commentCampaign.
+func (c *FakeCommentCampaigns) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.CommentCampaign, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(commentcampaignsResource, c.ns, name, data, subresources...), &github.CommentCampaign{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.CommentCampaign), err
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDiscussions implements DiscussionInterface
+type FakeDiscussions struct {
+	Fake *FakeGithub
+	ns   string
+}
+
+var discussionsResource = schema.GroupVersionResource{Group: "github", Version: "", Resource: "discussions"}
+
+var discussionsKind = schema.GroupVersionKind{Group: "github", Version: "", Kind: "Discussion"}
+
+// Get takes name of the discussion, and returns the corresponding discussion object, and an error if there is any.
+func (c *FakeDiscussions) Get(name string, options v1.GetOptions) (result *github.Discussion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(discussionsResource, c.ns, name), &github.Discussion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.Discussion), err
+}
+
+// List takes label and field selectors, and returns the list of Discussions that match those selectors.
+func (c *FakeDiscussions) List(opts v1.ListOptions) (result *github.DiscussionList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(discussionsResource, discussionsKind, c.ns, opts), &github.DiscussionList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &github.DiscussionList{}
+	for _, item := range obj.(*github.DiscussionList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested discussions.
+func (c *FakeDiscussions) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(discussionsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a discussion and creates it.  Returns the server's representation of the discussion, and an error, if there is any.
+func (c *FakeDiscussions) Create(discussion *github.Discussion) (result *github.Discussion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(discussionsResource, c.ns, discussion), &github.Discussion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.Discussion), err
+}
+
+// Update takes the representation of a discussion and updates it. Returns the server's representation of the discussion, and an error, if there is any.
+func (c *FakeDiscussions) Update(discussion *github.Discussion) (result *github.Discussion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(discussionsResource, c.ns, discussion), &github.Discussion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.Discussion), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDiscussions) UpdateStatus(discussion *github.Discussion) (*github.Discussion, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(discussionsResource, "status", c.ns, discussion), &github.Discussion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.Discussion), err
+}
+
+// Delete takes name of the discussion and deletes it. Returns an error if one occurs.
+func (c *FakeDiscussions) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(discussionsResource, c.ns, name), &github.Discussion{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDiscussions) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(discussionsResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &github.DiscussionList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched discussion.
+func (c *FakeDiscussions) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.Discussion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(discussionsResource, c.ns, name, data, subresources...), &github.Discussion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.Discussion), err
+}
@@ -26,10 +26,42 @@ type FakeGithub struct {
 	*testing.Fake
 }
 
+func (c *FakeGithub) ActionsSecrets(namespace string) internalversion.ActionsSecretInterface {
+	return &FakeActionsSecrets{c, namespace}
+}
+
+func (c *FakeGithub) ClusterComments() internalversion.ClusterCommentInterface {
+	return &FakeClusterComments{c}
+}
+
 func (c *FakeGithub) Comments(namespace string) internalversion.CommentInterface {
 	return &FakeComments{c, namespace}
 }
 
+func (c *FakeGithub) CommentCampaigns(namespace string) internalversion.CommentCampaignInterface {
+	return &FakeCommentCampaigns{c, namespace}
+}
+
+func (c *FakeGithub) Discussions(namespace string) internalversion.DiscussionInterface {
+	return &FakeDiscussions{c, namespace}
+}
+
+func (c *FakeGithub) NotificationRules(namespace string) internalversion.NotificationRuleInterface {
+	return &FakeNotificationRules{c, namespace}
+}
+
+func (c *FakeGithub) PullRequests(namespace string) internalversion.PullRequestInterface {
+	return &FakePullRequests{c, namespace}
+}
+
+func (c *FakeGithub) RepositoryFiles(namespace string) internalversion.RepositoryFileInterface {
+	return &FakeRepositoryFiles{c, namespace}
+}
+
+func (c *FakeGithub) WorkflowTriggers(namespace string) internalversion.WorkflowTriggerInterface {
+	return &FakeWorkflowTriggers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeGithub) RESTClient() rest.Interface {
@@ -0,0 +1,138 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakePullRequests implements PullRequestInterface
+type FakePullRequests struct {
+	Fake *FakeGithub
+	ns   string
+}
+
+var pullrequestsResource = schema.GroupVersionResource{Group: "github", Version: "", Resource: "pullrequests"}
+
+var pullrequestsKind = schema.GroupVersionKind{Group: "github", Version: "", Kind: "PullRequest"}
+
+// Get takes name of the pullRequest, and returns the corresponding pullRequest object, and an error if there is any.
+func (c *FakePullRequests) Get(name string, options v1.GetOptions) (result *github.PullRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(pullrequestsResource, c.ns, name), &github.PullRequest{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.PullRequest), err
+}
+
+// List takes label and field selectors, and returns the list of PullRequests that match those selectors.
+func (c *FakePullRequests) List(opts v1.ListOptions) (result *github.PullRequestList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(pullrequestsResource, pullrequestsKind, c.ns, opts), &github.PullRequestList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &github.PullRequestList{}
+	for _, item := range obj.(*github.PullRequestList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested pullRequests.
+func (c *FakePullRequests) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(pullrequestsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a pullRequest and creates it.  Returns the server's representation of the pullRequest, and an error, if there is any.
+func (c *FakePullRequests) Create(pullRequest *github.PullRequest) (result *github.PullRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(pullrequestsResource, c.ns, pullRequest), &github.PullRequest{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.PullRequest), err
+}
+
+// Update takes the representation of a pullRequest and updates it. Returns the server's representation of the pullRequest, and an error, if there is any.
+func (c *FakePullRequests) Update(pullRequest *github.PullRequest) (result *github.PullRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(pullrequestsResource, c.ns, pullRequest), &github.PullRequest{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.PullRequest), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakePullRequests) UpdateStatus(pullRequest *github.PullRequest) (*github.PullRequest, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(pullrequestsResource, "status", c.ns, pullRequest), &github.PullRequest{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.PullRequest), err
+}
+
+// Delete takes name of the pullRequest and deletes it. Returns an error if one occurs.
+func (c *FakePullRequests) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(pullrequestsResource, c.ns, name), &github.PullRequest{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakePullRequests) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(pullrequestsResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &github.PullRequestList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched pullRequest.
+func (c *FakePullRequests) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.PullRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(pullrequestsResource, c.ns, name, data, subresources...), &github.PullRequest{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github.PullRequest), err
+}
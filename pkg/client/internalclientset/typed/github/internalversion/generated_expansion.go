@@ -16,4 +16,20 @@ limitations under the License.
 
 package internalversion
 
+type ActionsSecretExpansion interface{}
+
+type ClusterCommentExpansion interface{}
+
 type CommentExpansion interface{}
+
+type CommentCampaignExpansion interface{}
+
+type DiscussionExpansion interface{}
+
+type NotificationRuleExpansion interface{}
+
+type PullRequestExpansion interface{}
+
+type RepositoryFileExpansion interface{}
+
+type WorkflowTriggerExpansion interface{}
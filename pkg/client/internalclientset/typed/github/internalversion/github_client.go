@@ -23,7 +23,15 @@ import (
 
 type GithubInterface interface {
 	RESTClient() rest.Interface
+	ActionsSecretsGetter
+	ClusterCommentsGetter
 	CommentsGetter
+	CommentCampaignsGetter
+	DiscussionsGetter
+	NotificationRulesGetter
+	PullRequestsGetter
+	RepositoryFilesGetter
+	WorkflowTriggersGetter
 }
 
 // GithubClient is used to interact with features provided by the github group.
@@ -31,10 +39,42 @@ type GithubClient struct {
 	restClient rest.Interface
 }
 
+func (c *GithubClient) ActionsSecrets(namespace string) ActionsSecretInterface {
+	return newActionsSecrets(c, namespace)
+}
+
+func (c *GithubClient) ClusterComments() ClusterCommentInterface {
+	return newClusterComments(c)
+}
+
 func (c *GithubClient) Comments(namespace string) CommentInterface {
 	return newComments(c, namespace)
 }
 
+func (c *GithubClient) CommentCampaigns(namespace string) CommentCampaignInterface {
+	return newCommentCampaigns(c, namespace)
+}
+
+func (c *GithubClient) Discussions(namespace string) DiscussionInterface {
+	return newDiscussions(c, namespace)
+}
+
+func (c *GithubClient) NotificationRules(namespace string) NotificationRuleInterface {
+	return newNotificationRules(c, namespace)
+}
+
+func (c *GithubClient) PullRequests(namespace string) PullRequestInterface {
+	return newPullRequests(c, namespace)
+}
+
+func (c *GithubClient) RepositoryFiles(namespace string) RepositoryFileInterface {
+	return newRepositoryFiles(c, namespace)
+}
+
+func (c *GithubClient) WorkflowTriggers(namespace string) WorkflowTriggerInterface {
+	return newWorkflowTriggers(c, namespace)
+}
+
 // NewForConfig creates a new GithubClient for the given config.
 func NewForConfig(c *rest.Config) (*GithubClient, error) {
 	config := *c
@@ -0,0 +1,172 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internalversion
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	scheme "github.com/nikhita/kube-custom-controller/pkg/client/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// NotificationRulesGetter has a method to return a NotificationRuleInterface.
+// A group's client should implement this interface.
+type NotificationRulesGetter interface {
+	NotificationRules(namespace string) NotificationRuleInterface
+}
+
+// NotificationRuleInterface has methods to work with NotificationRule resources.
+type NotificationRuleInterface interface {
+	Create(*github.NotificationRule) (*github.NotificationRule, error)
+	Update(*github.NotificationRule) (*github.NotificationRule, error)
+	UpdateStatus(*github.NotificationRule) (*github.NotificationRule, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*github.NotificationRule, error)
+	List(opts v1.ListOptions) (*github.NotificationRuleList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.NotificationRule, err error)
+	NotificationRuleExpansion
+}
+
+// notificationRules implements NotificationRuleInterface
+type notificationRules struct {
+	client rest.Interface
+	ns     string
+}
+
+// newNotificationRules returns a NotificationRules
+func newNotificationRules(c *GithubClient, namespace string) *notificationRules {
+	return &notificationRules{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the notificationRule, and returns the corresponding notificationRule object, and an error if there is any.
+func (c *notificationRules) Get(name string, options v1.GetOptions) (result *github.NotificationRule, err error) {
+	result = &github.NotificationRule{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("notificationrules").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of NotificationRules that match those selectors.
+func (c *notificationRules) List(opts v1.ListOptions) (result *github.NotificationRuleList, err error) {
+	result = &github.NotificationRuleList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("notificationrules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested notificationRules.
+func (c *notificationRules) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("notificationrules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Watch()
+}
+
+// Create takes the representation of a notificationRule and creates it.  Returns the server's representation of the notificationRule, and an error, if there is any.
+func (c *notificationRules) Create(notificationRule *github.NotificationRule) (result *github.NotificationRule, err error) {
+	result = &github.NotificationRule{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("notificationrules").
+		Body(notificationRule).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a notificationRule and updates it. Returns the server's representation of the notificationRule, and an error, if there is any.
+func (c *notificationRules) Update(notificationRule *github.NotificationRule) (result *github.NotificationRule, err error) {
+	result = &github.NotificationRule{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("notificationrules").
+		Name(notificationRule.Name).
+		Body(notificationRule).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *notificationRules) UpdateStatus(notificationRule *github.NotificationRule) (result *github.NotificationRule, err error) {
+	result = &github.NotificationRule{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("notificationrules").
+		Name(notificationRule.Name).
+		SubResource("status").
+		Body(notificationRule).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the notificationRule and deletes it. Returns an error if one occurs.
+func (c *notificationRules) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("notificationrules").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *notificationRules) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("notificationrules").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched notificationRule.
+func (c *notificationRules) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.NotificationRule, err error) {
+	result = &github.NotificationRule{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("notificationrules").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
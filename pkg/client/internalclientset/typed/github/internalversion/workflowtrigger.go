@@ -0,0 +1,172 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internalversion
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	scheme "github.com/nikhita/kube-custom-controller/pkg/client/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// WorkflowTriggersGetter has a method to return a WorkflowTriggerInterface.
+// A group's client should implement this interface.
+type WorkflowTriggersGetter interface {
+	WorkflowTriggers(namespace string) WorkflowTriggerInterface
+}
+
+// WorkflowTriggerInterface has methods to work with WorkflowTrigger resources.
+type WorkflowTriggerInterface interface {
+	Create(*github.WorkflowTrigger) (*github.WorkflowTrigger, error)
+	Update(*github.WorkflowTrigger) (*github.WorkflowTrigger, error)
+	UpdateStatus(*github.WorkflowTrigger) (*github.WorkflowTrigger, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*github.WorkflowTrigger, error)
+	List(opts v1.ListOptions) (*github.WorkflowTriggerList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.WorkflowTrigger, err error)
+	WorkflowTriggerExpansion
+}
+
+// workflowTriggers implements WorkflowTriggerInterface
+type workflowTriggers struct {
+	client rest.Interface
+	ns     string
+}
+
+// newWorkflowTriggers returns a WorkflowTriggers
+func newWorkflowTriggers(c *GithubClient, namespace string) *workflowTriggers {
+	return &workflowTriggers{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the workflowTrigger, and returns the corresponding workflowTrigger object, and an error if there is any.
+func (c *workflowTriggers) Get(name string, options v1.GetOptions) (result *github.WorkflowTrigger, err error) {
+	result = &github.WorkflowTrigger{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("workflowtriggers").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of WorkflowTriggers that match those selectors.
+func (c *workflowTriggers) List(opts v1.ListOptions) (result *github.WorkflowTriggerList, err error) {
+	result = &github.WorkflowTriggerList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("workflowtriggers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested workflowTriggers.
+func (c *workflowTriggers) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("workflowtriggers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Watch()
+}
+
+// Create takes the representation of a workflowTrigger and creates it.  Returns the server's representation of the workflowTrigger, and an error, if there is any.
+func (c *workflowTriggers) Create(workflowTrigger *github.WorkflowTrigger) (result *github.WorkflowTrigger, err error) {
+	result = &github.WorkflowTrigger{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("workflowtriggers").
+		Body(workflowTrigger).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a workflowTrigger and updates it. Returns the server's representation of the workflowTrigger, and an error, if there is any.
+func (c *workflowTriggers) Update(workflowTrigger *github.WorkflowTrigger) (result *github.WorkflowTrigger, err error) {
+	result = &github.WorkflowTrigger{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("workflowtriggers").
+		Name(workflowTrigger.Name).
+		Body(workflowTrigger).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *workflowTriggers) UpdateStatus(workflowTrigger *github.WorkflowTrigger) (result *github.WorkflowTrigger, err error) {
+	result = &github.WorkflowTrigger{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("workflowtriggers").
+		Name(workflowTrigger.Name).
+		SubResource("status").
+		Body(workflowTrigger).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the workflowTrigger and deletes it. Returns an error if one occurs.
+func (c *workflowTriggers) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("workflowtriggers").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *workflowTriggers) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("workflowtriggers").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched workflowTrigger.
+func (c *workflowTriggers) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github.WorkflowTrigger, err error) {
+	result = &github.WorkflowTrigger{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("workflowtriggers").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	scheme "github.com/nikhita/kube-custom-controller/pkg/client/scheme"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ActionsSecretsGetter has a method to return a ActionsSecretInterface.
+// A group's client should implement this interface.
+type ActionsSecretsGetter interface {
+	ActionsSecrets(namespace string) ActionsSecretInterface
+}
+
+// ActionsSecretInterface has methods to work with ActionsSecret resources.
+type ActionsSecretInterface interface {
+	Create(*v1.ActionsSecret) (*v1.ActionsSecret, error)
+	Update(*v1.ActionsSecret) (*v1.ActionsSecret, error)
+	UpdateStatus(*v1.ActionsSecret) (*v1.ActionsSecret, error)
+	Delete(name string, options *meta_v1.DeleteOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Get(name string, options meta_v1.GetOptions) (*v1.ActionsSecret, error)
+	List(opts meta_v1.ListOptions) (*v1.ActionsSecretList, error)
+	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ActionsSecret, err error)
+	ActionsSecretExpansion
+}
+
+// actionsSecrets implements ActionsSecretInterface
+type actionsSecrets struct {
+	client rest.Interface
+	ns     string
+}
+
+// newActionsSecrets returns a ActionsSecrets
+func newActionsSecrets(c *GithubV1Client, namespace string) *actionsSecrets {
+	return &actionsSecrets{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the actionsSecret, and returns the corresponding actionsSecret object, and an error if there is any.
+func (c *actionsSecrets) Get(name string, options meta_v1.GetOptions) (result *v1.ActionsSecret, err error) {
+	result = &v1.ActionsSecret{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("actionssecrets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ActionsSecrets that match those selectors.
+func (c *actionsSecrets) List(opts meta_v1.ListOptions) (result *v1.ActionsSecretList, err error) {
+	result = &v1.ActionsSecretList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("actionssecrets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested actionsSecrets.
+func (c *actionsSecrets) Watch(opts meta_v1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("actionssecrets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Watch()
+}
+
+// Create takes the representation of a actionsSecret and creates it.  Returns the server's representation of the actionsSecret, and an error, if there is any.
+func (c *actionsSecrets) Create(actionsSecret *v1.ActionsSecret) (result *v1.ActionsSecret, err error) {
+	result = &v1.ActionsSecret{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("actionssecrets").
+		Body(actionsSecret).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a actionsSecret and updates it. Returns the server's representation of the actionsSecret, and an error, if there is any.
+func (c *actionsSecrets) Update(actionsSecret *v1.ActionsSecret) (result *v1.ActionsSecret, err error) {
+	result = &v1.ActionsSecret{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("actionssecrets").
+		Name(actionsSecret.Name).
+		Body(actionsSecret).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *actionsSecrets) UpdateStatus(actionsSecret *v1.ActionsSecret) (result *v1.ActionsSecret, err error) {
+	result = &v1.ActionsSecret{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("actionssecrets").
+		Name(actionsSecret.Name).
+		SubResource("status").
+		Body(actionsSecret).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the actionsSecret and deletes it. Returns an error if one occurs.
+func (c *actionsSecrets) Delete(name string, options *meta_v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("actionssecrets").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *actionsSecrets) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("actionssecrets").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched actionsSecret.
+func (c *actionsSecrets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ActionsSecret, err error) {
+	result = &v1.ActionsSecret{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("actionssecrets").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
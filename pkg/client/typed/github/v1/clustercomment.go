@@ -0,0 +1,161 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	scheme "github.com/nikhita/kube-custom-controller/pkg/client/scheme"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterCommentsGetter has a method to return a ClusterCommentInterface.
+// A group's client should implement this interface.
+type ClusterCommentsGetter interface {
+	ClusterComments() ClusterCommentInterface
+}
+
+// ClusterCommentInterface has methods to work with ClusterComment resources.
+type ClusterCommentInterface interface {
+	Create(*v1.ClusterComment) (*v1.ClusterComment, error)
+	Update(*v1.ClusterComment) (*v1.ClusterComment, error)
+	UpdateStatus(*v1.ClusterComment) (*v1.ClusterComment, error)
+	Delete(name string, options *meta_v1.DeleteOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Get(name string, options meta_v1.GetOptions) (*v1.ClusterComment, error)
+	List(opts meta_v1.ListOptions) (*v1.ClusterCommentList, error)
+	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ClusterComment, err error)
+	ClusterCommentExpansion
+}
+
+// clusterComments implements ClusterCommentInterface
+type clusterComments struct {
+	client rest.Interface
+}
+
+// newClusterComments returns a ClusterComments
+func newClusterComments(c *GithubV1Client) *clusterComments {
+	return &clusterComments{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterComment, and returns the corresponding clusterComment object, and an error if there is any.
+func (c *clusterComments) Get(name string, options meta_v1.GetOptions) (result *v1.ClusterComment, err error) {
+	result = &v1.ClusterComment{}
+	err = c.client.Get().
+		Resource("clustercomments").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterComments that match those selectors.
+func (c *clusterComments) List(opts meta_v1.ListOptions) (result *v1.ClusterCommentList, err error) {
+	result = &v1.ClusterCommentList{}
+	err = c.client.Get().
+		Resource("clustercomments").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterComments.
+func (c *clusterComments) Watch(opts meta_v1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clustercomments").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Watch()
+}
+
+// Create takes the representation of a clusterComment and creates it.  Returns the server's representation of the clusterComment, and an error, if there is any.
+func (c *clusterComments) Create(clusterComment *v1.ClusterComment) (result *v1.ClusterComment, err error) {
+	result = &v1.ClusterComment{}
+	err = c.client.Post().
+		Resource("clustercomments").
+		Body(clusterComment).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterComment and updates it. Returns the server's representation of the clusterComment, and an error, if there is any.
+func (c *clusterComments) Update(clusterComment *v1.ClusterComment) (result *v1.ClusterComment, err error) {
+	result = &v1.ClusterComment{}
+	err = c.client.Put().
+		Resource("clustercomments").
+		Name(clusterComment.Name).
+		Body(clusterComment).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *clusterComments) UpdateStatus(clusterComment *v1.ClusterComment) (result *v1.ClusterComment, err error) {
+	result = &v1.ClusterComment{}
+	err = c.client.Put().
+		Resource("clustercomments").
+		Name(clusterComment.Name).
+		SubResource("status").
+		Body(clusterComment).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterComment and deletes it. Returns an error if one occurs.
+func (c *clusterComments) Delete(name string, options *meta_v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clustercomments").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterComments) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+	return c.client.Delete().
+		Resource("clustercomments").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterComment.
+func (c *clusterComments) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ClusterComment, err error) {
+	result = &v1.ClusterComment{}
+	err = c.client.Patch(pt).
+		Resource("clustercomments").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
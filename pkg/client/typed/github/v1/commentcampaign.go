@@ -0,0 +1,172 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	scheme "github.com/nikhita/kube-custom-controller/pkg/client/scheme"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// CommentCampaignsGetter has a method to return a CommentCampaignInterface.
+// A group's client should implement this interface.
+type CommentCampaignsGetter interface {
+	CommentCampaigns(namespace string) CommentCampaignInterface
+}
+
+// CommentCampaignInterface has methods to work with CommentCampaign resources.
+type CommentCampaignInterface interface {
+	Create(*v1.CommentCampaign) (*v1.CommentCampaign, error)
+	Update(*v1.CommentCampaign) (*v1.CommentCampaign, error)
+	UpdateStatus(*v1.CommentCampaign) (*v1.CommentCampaign, error)
+	Delete(name string, options *meta_v1.DeleteOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Get(name string, options meta_v1.GetOptions) (*v1.CommentCampaign, error)
+	List(opts meta_v1.ListOptions) (*v1.CommentCampaignList, error)
+	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.CommentCampaign, err error)
+	CommentCampaignExpansion
+}
+
+// commentCampaigns implements CommentCampaignInterface
+type commentCampaigns struct {
+	client rest.Interface
+	ns     string
+}
+
+// newCommentCampaigns returns a CommentCampaigns
+func newCommentCampaigns(c *GithubV1Client, namespace string) *commentCampaigns {
+	return &commentCampaigns{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the commentCampaign, and returns the corresponding commentCampaign object, and an error if there is any.
+func (c *commentCampaigns) Get(name string, options meta_v1.GetOptions) (result *v1.CommentCampaign, err error) {
+	result = &v1.CommentCampaign{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of CommentCampaigns that match those selectors.
+func (c *commentCampaigns) List(opts meta_v1.ListOptions) (result *v1.CommentCampaignList, err error) {
+	result = &v1.CommentCampaignList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested commentCampaigns.
+func (c *commentCampaigns) Watch(opts meta_v1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Watch()
+}
+
+// Create takes the representation of a commentCampaign and creates it.  Returns the server's representation of the commentCampaign, and an error, if there is any.
+func (c *commentCampaigns) Create(commentCampaign *v1.CommentCampaign) (result *v1.CommentCampaign, err error) {
+	result = &v1.CommentCampaign{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		Body(commentCampaign).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a commentCampaign and updates it. Returns the server's representation of the commentCampaign, and an error, if there is any.
+func (c *commentCampaigns) Update(commentCampaign *v1.CommentCampaign) (result *v1.CommentCampaign, err error) {
+	result = &v1.CommentCampaign{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		Name(commentCampaign.Name).
+		Body(commentCampaign).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *commentCampaigns) UpdateStatus(commentCampaign *v1.CommentCampaign) (result *v1.CommentCampaign, err error) {
+	result = &v1.CommentCampaign{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		Name(commentCampaign.Name).
+		SubResource("status").
+		Body(commentCampaign).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the commentCampaign and deletes it. Returns an error if one occurs.
+func (c *commentCampaigns) Delete(name string, options *meta_v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *commentCampaigns) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched commentCampaign.
+func (c *commentCampaigns) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.CommentCampaign, err error) {
+	result = &v1.CommentCampaign{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("commentcampaigns").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	github_v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeActionsSecrets implements ActionsSecretInterface
+type FakeActionsSecrets struct {
+	Fake *FakeGithubV1
+	ns   string
+}
+
+var actionssecretsResource = schema.GroupVersionResource{Group: "github.k8s.io", Version: "v1", Resource: "actionssecrets"}
+
+var actionssecretsKind = schema.GroupVersionKind{Group: "github.k8s.io", Version: "v1", Kind: "ActionsSecret"}
+
+// Get takes name of the actionsSecret, and returns the corresponding actionsSecret object, and an error if there is any.
+func (c *FakeActionsSecrets) Get(name string, options v1.GetOptions) (result *github_v1.ActionsSecret, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(actionssecretsResource, c.ns, name), &github_v1.ActionsSecret{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.ActionsSecret), err
+}
+
+// List takes label and field selectors, and returns the list of ActionsSecrets that match those selectors.
+func (c *FakeActionsSecrets) List(opts v1.ListOptions) (result *github_v1.ActionsSecretList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(actionssecretsResource, actionssecretsKind, c.ns, opts), &github_v1.ActionsSecretList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &github_v1.ActionsSecretList{}
+	for _, item := range obj.(*github_v1.ActionsSecretList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested actionsSecrets.
+func (c *FakeActionsSecrets) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(actionssecretsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a actionsSecret and creates it.  Returns the server's representation of the actionsSecret, and an error, if there is any.
+func (c *FakeActionsSecrets) Create(actionsSecret *github_v1.ActionsSecret) (result *github_v1.ActionsSecret, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(actionssecretsResource, c.ns, actionsSecret), &github_v1.ActionsSecret{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.ActionsSecret), err
+}
+
+// Update takes the representation of a actionsSecret and updates it. Returns the server's representation of the actionsSecret, and an error, if there is any.
+func (c *FakeActionsSecrets) Update(actionsSecret *github_v1.ActionsSecret) (result *github_v1.ActionsSecret, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(actionssecretsResource, c.ns, actionsSecret), &github_v1.ActionsSecret{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.ActionsSecret), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeActionsSecrets) UpdateStatus(actionsSecret *github_v1.ActionsSecret) (*github_v1.ActionsSecret, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(actionssecretsResource, "status", c.ns, actionsSecret), &github_v1.ActionsSecret{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.ActionsSecret), err
+}
+
+// Delete takes name of the actionsSecret and deletes it. Returns an error if one occurs.
+func (c *FakeActionsSecrets) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(actionssecretsResource, c.ns, name), &github_v1.ActionsSecret{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeActionsSecrets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(actionssecretsResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &github_v1.ActionsSecretList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched actionsSecret.
+func (c *FakeActionsSecrets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github_v1.ActionsSecret, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(actionssecretsResource, c.ns, name, data, subresources...), &github_v1.ActionsSecret{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.ActionsSecret), err
+}
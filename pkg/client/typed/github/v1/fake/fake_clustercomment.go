@@ -0,0 +1,137 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	github_v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeClusterComments implements ClusterCommentInterface
+type FakeClusterComments struct {
+	Fake *FakeGithubV1
+}
+
+var clustercommentsResource = schema.GroupVersionResource{Group: "github.k8s.io", Version: "v1", Resource: "clustercomments"}
+
+var clustercommentsKind = schema.GroupVersionKind{Group: "github.k8s.io", Version: "v1", Kind: "ClusterComment"}
+
+// Get takes name of the clusterComment, and returns the corresponding clusterComment object, and an error if there is any.
+func (c *FakeClusterComments) Get(name string, options v1.GetOptions) (result *github_v1.ClusterComment, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(clustercommentsResource, name), &github_v1.ClusterComment{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.ClusterComment), err
+}
+
+// List takes label and field selectors, and returns the list of ClusterComments that match those selectors.
+func (c *FakeClusterComments) List(opts v1.ListOptions) (result *github_v1.ClusterCommentList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(clustercommentsResource, clustercommentsKind, opts), &github_v1.ClusterCommentList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &github_v1.ClusterCommentList{}
+	for _, item := range obj.(*github_v1.ClusterCommentList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested clusterComments.
+func (c *FakeClusterComments) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(clustercommentsResource, opts))
+
+}
+
+// Create takes the representation of a clusterComment and creates it.  Returns the server's representation of the clusterComment, and an error, if there is any.
+func (c *FakeClusterComments) Create(clusterComment *github_v1.ClusterComment) (result *github_v1.ClusterComment, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(clustercommentsResource, clusterComment), &github_v1.ClusterComment{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.ClusterComment), err
+}
+
+// Update takes the representation of a clusterComment and updates it. Returns the server's representation of the clusterComment, and an error, if there is any.
+func (c *FakeClusterComments) Update(clusterComment *github_v1.ClusterComment) (result *github_v1.ClusterComment, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(clustercommentsResource, clusterComment), &github_v1.ClusterComment{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.ClusterComment), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeClusterComments) UpdateStatus(clusterComment *github_v1.ClusterComment) (*github_v1.ClusterComment, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(clustercommentsResource, "status", clusterComment), &github_v1.ClusterComment{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.ClusterComment), err
+}
+
+// Delete takes name of the clusterComment and deletes it. Returns an error if one occurs.
+func (c *FakeClusterComments) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(clustercommentsResource, name), &github_v1.ClusterComment{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeClusterComments) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(clustercommentsResource, listOptions)
+
+	_, err := c.Fake.Invokes(action, &github_v1.ClusterCommentList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched clusterComment.
+func (c *FakeClusterComments) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github_v1.ClusterComment, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(clustercommentsResource, name, data, subresources...), &github_v1.ClusterComment{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.ClusterComment), err
+}
@@ -26,10 +26,42 @@ type FakeGithubV1 struct {
 	*testing.Fake
 }
 
+func (c *FakeGithubV1) ActionsSecrets(namespace string) v1.ActionsSecretInterface {
+	return &FakeActionsSecrets{c, namespace}
+}
+
+func (c *FakeGithubV1) ClusterComments() v1.ClusterCommentInterface {
+	return &FakeClusterComments{c}
+}
+
 func (c *FakeGithubV1) Comments(namespace string) v1.CommentInterface {
 	return &FakeComments{c, namespace}
 }
 
+func (c *FakeGithubV1) CommentCampaigns(namespace string) v1.CommentCampaignInterface {
+	return &FakeCommentCampaigns{c, namespace}
+}
+
+func (c *FakeGithubV1) Discussions(namespace string) v1.DiscussionInterface {
+	return &FakeDiscussions{c, namespace}
+}
+
+func (c *FakeGithubV1) NotificationRules(namespace string) v1.NotificationRuleInterface {
+	return &FakeNotificationRules{c, namespace}
+}
+
+func (c *FakeGithubV1) PullRequests(namespace string) v1.PullRequestInterface {
+	return &FakePullRequests{c, namespace}
+}
+
+func (c *FakeGithubV1) RepositoryFiles(namespace string) v1.RepositoryFileInterface {
+	return &FakeRepositoryFiles{c, namespace}
+}
+
+func (c *FakeGithubV1) WorkflowTriggers(namespace string) v1.WorkflowTriggerInterface {
+	return &FakeWorkflowTriggers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeGithubV1) RESTClient() rest.Interface {
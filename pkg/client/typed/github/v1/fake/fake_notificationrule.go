@@ -0,0 +1,138 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	github_v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNotificationRules implements NotificationRuleInterface
+type FakeNotificationRules struct {
+	Fake *FakeGithubV1
+	ns   string
+}
+
+var notificationrulesResource = schema.GroupVersionResource{Group: "github.k8s.io", Version: "v1", Resource: "notificationrules"}
+
+var notificationrulesKind = schema.GroupVersionKind{Group: "github.k8s.io", Version: "v1", Kind: "NotificationRule"}
+
+// Get takes name of the notificationRule, and returns the corresponding notificationRule object, and an error if there is any.
+func (c *FakeNotificationRules) Get(name string, options v1.GetOptions) (result *github_v1.NotificationRule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(notificationrulesResource, c.ns, name), &github_v1.NotificationRule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.NotificationRule), err
+}
+
+// List takes label and field selectors, and returns the list of NotificationRules that match those selectors.
+func (c *FakeNotificationRules) List(opts v1.ListOptions) (result *github_v1.NotificationRuleList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(notificationrulesResource, notificationrulesKind, c.ns, opts), &github_v1.NotificationRuleList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &github_v1.NotificationRuleList{}
+	for _, item := range obj.(*github_v1.NotificationRuleList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested notificationRules.
+func (c *FakeNotificationRules) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(notificationrulesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a notificationRule and creates it.  Returns the server's representation of the notificationRule, and an error, if there is any.
+func (c *FakeNotificationRules) Create(notificationRule *github_v1.NotificationRule) (result *github_v1.NotificationRule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(notificationrulesResource, c.ns, notificationRule), &github_v1.NotificationRule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.NotificationRule), err
+}
+
+// Update takes the representation of a notificationRule and updates it. Returns the server's representation of the notificationRule, and an error, if there is any.
+func (c *FakeNotificationRules) Update(notificationRule *github_v1.NotificationRule) (result *github_v1.NotificationRule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(notificationrulesResource, c.ns, notificationRule), &github_v1.NotificationRule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.NotificationRule), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeNotificationRules) UpdateStatus(notificationRule *github_v1.NotificationRule) (*github_v1.NotificationRule, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(notificationrulesResource, "status", c.ns, notificationRule), &github_v1.NotificationRule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.NotificationRule), err
+}
+
+// Delete takes name of the notificationRule and deletes it. Returns an error if one occurs.
+func (c *FakeNotificationRules) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(notificationrulesResource, c.ns, name), &github_v1.NotificationRule{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNotificationRules) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(notificationrulesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &github_v1.NotificationRuleList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched notificationRule.
+func (c *FakeNotificationRules) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github_v1.NotificationRule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(notificationrulesResource, c.ns, name, data, subresources...), &github_v1.NotificationRule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.NotificationRule), err
+}
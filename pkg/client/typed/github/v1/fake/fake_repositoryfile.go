@@ -0,0 +1,138 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	github_v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRepositoryFiles implements RepositoryFileInterface
+type FakeRepositoryFiles struct {
+	Fake *FakeGithubV1
+	ns   string
+}
+
+var repositoryfilesResource = schema.GroupVersionResource{Group: "github.k8s.io", Version: "v1", Resource: "repositoryfiles"}
+
+var repositoryfilesKind = schema.GroupVersionKind{Group: "github.k8s.io", Version: "v1", Kind: "RepositoryFile"}
+
+// Get takes name of the repositoryFile, and returns the corresponding repositoryFile object, and an error if there is any.
+func (c *FakeRepositoryFiles) Get(name string, options v1.GetOptions) (result *github_v1.RepositoryFile, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(repositoryfilesResource, c.ns, name), &github_v1.RepositoryFile{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.RepositoryFile), err
+}
+
+// List takes label and field selectors, and returns the list of RepositoryFiles that match those selectors.
+func (c *FakeRepositoryFiles) List(opts v1.ListOptions) (result *github_v1.RepositoryFileList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(repositoryfilesResource, repositoryfilesKind, c.ns, opts), &github_v1.RepositoryFileList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &github_v1.RepositoryFileList{}
+	for _, item := range obj.(*github_v1.RepositoryFileList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested repositoryFiles.
+func (c *FakeRepositoryFiles) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(repositoryfilesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a repositoryFile and creates it.  Returns the server's representation of the repositoryFile, and an error, if there is any.
+func (c *FakeRepositoryFiles) Create(repositoryFile *github_v1.RepositoryFile) (result *github_v1.RepositoryFile, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(repositoryfilesResource, c.ns, repositoryFile), &github_v1.RepositoryFile{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.RepositoryFile), err
+}
+
+// Update takes the representation of a repositoryFile and updates it. Returns the server's representation of the repositoryFile, and an error, if there is any.
+func (c *FakeRepositoryFiles) Update(repositoryFile *github_v1.RepositoryFile) (result *github_v1.RepositoryFile, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(repositoryfilesResource, c.ns, repositoryFile), &github_v1.RepositoryFile{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.RepositoryFile), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRepositoryFiles) UpdateStatus(repositoryFile *github_v1.RepositoryFile) (*github_v1.RepositoryFile, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(repositoryfilesResource, "status", c.ns, repositoryFile), &github_v1.RepositoryFile{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.RepositoryFile), err
+}
+
+// Delete takes name of the repositoryFile and deletes it. Returns an error if one occurs.
+func (c *FakeRepositoryFiles) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(repositoryfilesResource, c.ns, name), &github_v1.RepositoryFile{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRepositoryFiles) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(repositoryfilesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &github_v1.RepositoryFileList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched repositoryFile.
+func (c *FakeRepositoryFiles) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github_v1.RepositoryFile, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(repositoryfilesResource, c.ns, name, data, subresources...), &github_v1.RepositoryFile{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.RepositoryFile), err
+}
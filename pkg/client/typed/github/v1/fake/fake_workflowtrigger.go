@@ -0,0 +1,138 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	github_v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeWorkflowTriggers implements WorkflowTriggerInterface
+type FakeWorkflowTriggers struct {
+	Fake *FakeGithubV1
+	ns   string
+}
+
+var workflowtriggersResource = schema.GroupVersionResource{Group: "github.k8s.io", Version: "v1", Resource: "workflowtriggers"}
+
+var workflowtriggersKind = schema.GroupVersionKind{Group: "github.k8s.io", Version: "v1", Kind: "WorkflowTrigger"}
+
+// Get takes name of the workflowTrigger, and returns the corresponding workflowTrigger object, and an error if there is any.
+func (c *FakeWorkflowTriggers) Get(name string, options v1.GetOptions) (result *github_v1.WorkflowTrigger, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(workflowtriggersResource, c.ns, name), &github_v1.WorkflowTrigger{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.WorkflowTrigger), err
+}
+
+// List takes label and field selectors, and returns the list of WorkflowTriggers that match those selectors.
+func (c *FakeWorkflowTriggers) List(opts v1.ListOptions) (result *github_v1.WorkflowTriggerList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(workflowtriggersResource, workflowtriggersKind, c.ns, opts), &github_v1.WorkflowTriggerList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &github_v1.WorkflowTriggerList{}
+	for _, item := range obj.(*github_v1.WorkflowTriggerList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested workflowTriggers.
+func (c *FakeWorkflowTriggers) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(workflowtriggersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a workflowTrigger and creates it.  Returns the server's representation of the workflowTrigger, and an error, if there is any.
+func (c *FakeWorkflowTriggers) Create(workflowTrigger *github_v1.WorkflowTrigger) (result *github_v1.WorkflowTrigger, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(workflowtriggersResource, c.ns, workflowTrigger), &github_v1.WorkflowTrigger{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.WorkflowTrigger), err
+}
+
+// Update takes the representation of a workflowTrigger and updates it. Returns the server's representation of the workflowTrigger, and an error, if there is any.
+func (c *FakeWorkflowTriggers) Update(workflowTrigger *github_v1.WorkflowTrigger) (result *github_v1.WorkflowTrigger, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(workflowtriggersResource, c.ns, workflowTrigger), &github_v1.WorkflowTrigger{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.WorkflowTrigger), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeWorkflowTriggers) UpdateStatus(workflowTrigger *github_v1.WorkflowTrigger) (*github_v1.WorkflowTrigger, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(workflowtriggersResource, "status", c.ns, workflowTrigger), &github_v1.WorkflowTrigger{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.WorkflowTrigger), err
+}
+
+// Delete takes name of the workflowTrigger and deletes it. Returns an error if one occurs.
+func (c *FakeWorkflowTriggers) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(workflowtriggersResource, c.ns, name), &github_v1.WorkflowTrigger{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeWorkflowTriggers) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(workflowtriggersResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &github_v1.WorkflowTriggerList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched workflowTrigger.
+func (c *FakeWorkflowTriggers) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *github_v1.WorkflowTrigger, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(workflowtriggersResource, c.ns, name, data, subresources...), &github_v1.WorkflowTrigger{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*github_v1.WorkflowTrigger), err
+}
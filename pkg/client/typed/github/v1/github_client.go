@@ -25,7 +25,15 @@ import (
 
 type GithubV1Interface interface {
 	RESTClient() rest.Interface
+	ActionsSecretsGetter
+	ClusterCommentsGetter
 	CommentsGetter
+	CommentCampaignsGetter
+	DiscussionsGetter
+	NotificationRulesGetter
+	PullRequestsGetter
+	RepositoryFilesGetter
+	WorkflowTriggersGetter
 }
 
 // GithubV1Client is used to interact with features provided by the github.k8s.io group.
@@ -33,10 +41,42 @@ type GithubV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *GithubV1Client) ActionsSecrets(namespace string) ActionsSecretInterface {
+	return newActionsSecrets(c, namespace)
+}
+
+func (c *GithubV1Client) ClusterComments() ClusterCommentInterface {
+	return newClusterComments(c)
+}
+
 func (c *GithubV1Client) Comments(namespace string) CommentInterface {
 	return newComments(c, namespace)
 }
 
+func (c *GithubV1Client) CommentCampaigns(namespace string) CommentCampaignInterface {
+	return newCommentCampaigns(c, namespace)
+}
+
+func (c *GithubV1Client) Discussions(namespace string) DiscussionInterface {
+	return newDiscussions(c, namespace)
+}
+
+func (c *GithubV1Client) NotificationRules(namespace string) NotificationRuleInterface {
+	return newNotificationRules(c, namespace)
+}
+
+func (c *GithubV1Client) PullRequests(namespace string) PullRequestInterface {
+	return newPullRequests(c, namespace)
+}
+
+func (c *GithubV1Client) RepositoryFiles(namespace string) RepositoryFileInterface {
+	return newRepositoryFiles(c, namespace)
+}
+
+func (c *GithubV1Client) WorkflowTriggers(namespace string) WorkflowTriggerInterface {
+	return newWorkflowTriggers(c, namespace)
+}
+
 // NewForConfig creates a new GithubV1Client for the given config.
 func NewForConfig(c *rest.Config) (*GithubV1Client, error) {
 	config := *c
@@ -0,0 +1,172 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	scheme "github.com/nikhita/kube-custom-controller/pkg/client/scheme"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RepositoryFilesGetter has a method to return a RepositoryFileInterface.
+// A group's client should implement this interface.
+type RepositoryFilesGetter interface {
+	RepositoryFiles(namespace string) RepositoryFileInterface
+}
+
+// RepositoryFileInterface has methods to work with RepositoryFile resources.
+type RepositoryFileInterface interface {
+	Create(*v1.RepositoryFile) (*v1.RepositoryFile, error)
+	Update(*v1.RepositoryFile) (*v1.RepositoryFile, error)
+	UpdateStatus(*v1.RepositoryFile) (*v1.RepositoryFile, error)
+	Delete(name string, options *meta_v1.DeleteOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Get(name string, options meta_v1.GetOptions) (*v1.RepositoryFile, error)
+	List(opts meta_v1.ListOptions) (*v1.RepositoryFileList, error)
+	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.RepositoryFile, err error)
+	RepositoryFileExpansion
+}
+
+// repositoryFiles implements RepositoryFileInterface
+type repositoryFiles struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRepositoryFiles returns a RepositoryFiles
+func newRepositoryFiles(c *GithubV1Client, namespace string) *repositoryFiles {
+	return &repositoryFiles{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the repositoryFile, and returns the corresponding repositoryFile object, and an error if there is any.
+func (c *repositoryFiles) Get(name string, options meta_v1.GetOptions) (result *v1.RepositoryFile, err error) {
+	result = &v1.RepositoryFile{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("repositoryfiles").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RepositoryFiles that match those selectors.
+func (c *repositoryFiles) List(opts meta_v1.ListOptions) (result *v1.RepositoryFileList, err error) {
+	result = &v1.RepositoryFileList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("repositoryfiles").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested repositoryFiles.
+func (c *repositoryFiles) Watch(opts meta_v1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("repositoryfiles").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Watch()
+}
+
+// Create takes the representation of a repositoryFile and creates it.  Returns the server's representation of the repositoryFile, and an error, if there is any.
+func (c *repositoryFiles) Create(repositoryFile *v1.RepositoryFile) (result *v1.RepositoryFile, err error) {
+	result = &v1.RepositoryFile{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("repositoryfiles").
+		Body(repositoryFile).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a repositoryFile and updates it. Returns the server's representation of the repositoryFile, and an error, if there is any.
+func (c *repositoryFiles) Update(repositoryFile *v1.RepositoryFile) (result *v1.RepositoryFile, err error) {
+	result = &v1.RepositoryFile{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("repositoryfiles").
+		Name(repositoryFile.Name).
+		Body(repositoryFile).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *repositoryFiles) UpdateStatus(repositoryFile *v1.RepositoryFile) (result *v1.RepositoryFile, err error) {
+	result = &v1.RepositoryFile{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("repositoryfiles").
+		Name(repositoryFile.Name).
+		SubResource("status").
+		Body(repositoryFile).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the repositoryFile and deletes it. Returns an error if one occurs.
+func (c *repositoryFiles) Delete(name string, options *meta_v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("repositoryfiles").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *repositoryFiles) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("repositoryfiles").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched repositoryFile.
+func (c *repositoryFiles) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.RepositoryFile, err error) {
+	result = &v1.RepositoryFile{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("repositoryfiles").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,399 @@
+// Package controller posts Comments and ClusterComments on Github, and keeps
+// the posted comments in sync with them.
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	"github.com/nikhita/kube-custom-controller/pkg/client"
+	factory "github.com/nikhita/kube-custom-controller/pkg/informers/externalversions"
+	listers "github.com/nikhita/kube-custom-controller/pkg/listers/github/v1"
+)
+
+// Name names the controller in logs, metrics and its queue.
+const Name = "comments"
+
+// builtinDefaultIssue is where Comments that name no issue are posted unless
+// SetDefaultIssue says otherwise.
+var builtinDefaultIssue = v1.IssueReference{Owner: "nikhita", Repository: "kube-custom-controller", Number: 2}
+
+// defaultIssue is where Comments that name no issue are posted.
+var defaultIssue = struct {
+	sync.RWMutex
+	issue v1.IssueReference
+}{issue: builtinDefaultIssue}
+
+// DefaultIssue returns where Comments that name no issue are posted.
+func DefaultIssue() v1.IssueReference {
+	defaultIssue.RLock()
+	defer defaultIssue.RUnlock()
+	return defaultIssue.issue
+}
+
+// SetDefaultIssue changes where Comments that name no issue are posted. It
+// may be called while the controller runs.
+func SetDefaultIssue(issue v1.IssueReference) {
+	defaultIssue.Lock()
+	defer defaultIssue.Unlock()
+	defaultIssue.issue = issue
+}
+
+// ResetDefaultIssue posts Comments that name no issue on the built-in default
+// issue again, undoing SetDefaultIssue.
+func ResetDefaultIssue() {
+	SetDefaultIssue(builtinDefaultIssue)
+}
+
+// Controller posts the message of every Comment and ClusterComment on
+// Github, and edits the posted comment whenever the message changes. Both
+// share the queue, ClusterComments are told apart by their key, which has no
+// namespace.
+type Controller struct {
+	// Instrument, if set, wraps every sync of a Comment or ClusterComment.
+	Instrument Instrument
+
+	client   client.Interface
+	github   GitHub
+	recorder record.EventRecorder
+
+	comments        []listers.CommentLister
+	clusterComments listers.ClusterCommentLister
+	synced          []cache.InformerSynced
+	queue           workqueue.RateLimitingInterface
+}
+
+// object is a Comment or a ClusterComment.
+type object interface {
+	kruntime.Object
+	metav1.Object
+}
+
+// New returns the controller of the Comments of 'informers', which are
+// updated through 'client' and posted through 'gh'. What happens to them is
+// recorded as Events through 'recorder', if it is not nil. Comments that
+// failed to sync are retried after the delay 'rateLimiter' returns, or with
+// an exponential backoff from 5 seconds up to a minute if it is nil.
+//
+// The Comment informer is created in 'informers', which has to be started
+// after New returned.
+func New(client client.Interface, informers factory.SharedInformerFactory, gh GitHub, recorder record.EventRecorder, rateLimiter workqueue.RateLimiter) *Controller {
+	if recorder == nil {
+		recorder = &record.FakeRecorder{}
+	}
+	if rateLimiter == nil {
+		rateLimiter = workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute)
+	}
+
+	c := &Controller{
+		client:   client,
+		github:   gh,
+		recorder: recorder,
+		queue:    workqueue.NewNamedRateLimitingQueue(rateLimiter, Name),
+	}
+	c.Watch(informers)
+	return c
+}
+
+// Watch makes the controller also sync the Comments of 'informers', which
+// must watch other namespaces than the factories given before. It must be
+// called before Run.
+func (c *Controller) Watch(informers factory.SharedInformerFactory) {
+	informer := informers.Github().V1().Comments()
+	c.comments = append(c.comments, informer.Lister())
+	c.synced = append(c.synced, informer.Informer().HasSynced)
+	informer.Informer().AddEventHandler(c.eventHandler())
+}
+
+// WatchClusterComments makes the controller also sync the ClusterComments of
+// 'informers', which must watch every namespace. It must be called before
+// Run.
+func (c *Controller) WatchClusterComments(informers factory.SharedInformerFactory) {
+	informer := informers.Github().V1().ClusterComments()
+	c.clusterComments = informer.Lister()
+	c.synced = append(c.synced, informer.Informer().HasSynced)
+	informer.Informer().AddEventHandler(c.eventHandler())
+}
+
+// eventHandler returns the informer event handlers that add changed objects
+// into the queue.
+func (c *Controller) eventHandler() cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueue,
+		UpdateFunc: func(old, cur interface{}) {
+			if !reflect.DeepEqual(old, cur) {
+				c.enqueue(cur)
+			}
+		},
+		DeleteFunc: c.enqueue,
+	}
+}
+
+// HasSynced reports whether the caches finished their initial sync.
+func (c *Controller) HasSynced() bool {
+	for _, synced := range c.synced {
+		if !synced() {
+			return false
+		}
+	}
+	return true
+}
+
+// Run waits for the caches to sync and starts 'workers' workers. It blocks
+// until 'ctx' is done, and then until the workers finished the objects they
+// are syncing.
+func (c *Controller) Run(ctx context.Context, workers int) error {
+	defer c.queue.ShutDown()
+
+	if !cache.WaitForCacheSync(ctx.Done(), c.synced...) {
+		return fmt.Errorf("error waiting for the Comment caches to sync")
+	}
+
+	// the queue never hands the same key to two workers at once, so an
+	// object is only ever synced by one of them at a time.
+	var running sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		running.Add(1)
+		go func() {
+			defer running.Done()
+			Work(Name, c.queue, c.process, c.Instrument)
+		}()
+	}
+	klog.InfoS("Started workers", "controller", Name, "workers", workers)
+
+	<-ctx.Done()
+	c.queue.ShutDown()
+	running.Wait()
+	return nil
+}
+
+// Requeue adds every Comment and ClusterComment in the caches into the
+// queue, e.g. so that the ones skipped while the controller was disabled are
+// synced.
+func (c *Controller) Requeue() {
+	for _, comments := range c.comments {
+		objs, err := comments.List(labels.Everything())
+		if err != nil {
+			runtime.HandleError(fmt.Errorf("error listing Comments: %w", err))
+			continue
+		}
+		for _, obj := range objs {
+			c.enqueue(obj)
+		}
+	}
+	if c.clusterComments == nil {
+		return
+	}
+	objs, err := c.clusterComments.List(labels.Everything())
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("error listing ClusterComments: %w", err))
+		return
+	}
+	for _, obj := range objs {
+		c.enqueue(obj)
+	}
+}
+
+// enqueue adds the key of the Comment or ClusterComment 'obj' into the
+// queue.
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("error obtaining key for object being enqueue: %w", err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// process retrieves the latest version of the Comment 'namespace/name', or
+// of the ClusterComment 'name' if 'namespace' is empty, from the caches and
+// syncs it.
+func (c *Controller) process(namespace, name string) error {
+	if namespace == "" {
+		return c.processClusterComment(name)
+	}
+
+	var obj *v1.Comment
+	for _, comments := range c.comments {
+		found, err := comments.Comments(namespace).Get(name)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error getting object '%s/%s' from api: %w", namespace, name, err)
+		}
+		obj = found
+		break
+	}
+	if obj == nil {
+		// deleted, the comment on Github is kept.
+		return nil
+	}
+
+	klog.V(4).InfoS("Syncing Comment", "comment", klog.KObj(obj), "resourceVersion", obj.ResourceVersion)
+
+	// never modify objects from the cache, other workers may be reading
+	// them. Requests are not cancelled on shutdown, so that the syncs in
+	// flight can finish.
+	return c.Sync(context.Background(), obj.DeepCopy())
+}
+
+// processClusterComment retrieves the latest version of the ClusterComment
+// 'name' from the cache and syncs it.
+func (c *Controller) processClusterComment(name string) error {
+	if c.clusterComments == nil {
+		return nil
+	}
+	obj, err := c.clusterComments.Get(name)
+	if errors.IsNotFound(err) {
+		// deleted, the comment on Github is kept.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting object '%s' from api: %w", name, err)
+	}
+
+	klog.V(4).InfoS("Syncing ClusterComment", "clusterComment", klog.KObj(obj), "resourceVersion", obj.ResourceVersion)
+	return c.SyncClusterComment(context.Background(), obj.DeepCopy())
+}
+
+// Sync posts the message of 'comment' if it was not posted yet, and edits the
+// posted comment if the message changed since, and records that in its
+// status.
+func (c *Controller) Sync(ctx context.Context, comment *v1.Comment) error {
+	return c.sync(ctx, comment, "Comment", comment.Spec, comment.Status, func(status v1.CommentStatus) error {
+		comment.Status = status
+		_, err := c.client.GithubV1().Comments(comment.Namespace).Update(comment)
+		return err
+	})
+}
+
+// SyncClusterComment does what Sync does for a Comment for 'comment'.
+func (c *Controller) SyncClusterComment(ctx context.Context, comment *v1.ClusterComment) error {
+	return c.sync(ctx, comment, "ClusterComment", comment.Spec, comment.Status, func(status v1.CommentStatus) error {
+		comment.Status = status
+		_, err := c.client.GithubV1().ClusterComments().Update(comment)
+		return err
+	})
+}
+
+// sync delivers 'spec' of 'obj', a 'kind' whose current status is 'status',
+// records what happened as Events on it, and saves the new status through
+// 'save'.
+func (c *Controller) sync(ctx context.Context, obj object, kind string, spec v1.CommentSpec, status v1.CommentStatus, save func(v1.CommentStatus) error) error {
+	logKey := strings.ToLower(kind[:1]) + kind[1:]
+
+	// retrying does not help until the spec is fixed, which enqueues the
+	// object again.
+	if err := ValidateSpec(spec); err != nil {
+		c.recorder.Event(obj, corev1.EventTypeWarning, "InvalidSpec", err.Error())
+		klog.InfoS("Skipping invalid "+kind, logKey, klog.KObj(obj), "resourceVersion", obj.GetResourceVersion(), "reason", err.Error())
+		return nil
+	}
+
+	// send the comment now, or edit it
+	delivered, err := Deliver(ctx, c.github, spec, status)
+	if err != nil {
+		c.recorder.Eventf(obj, corev1.EventTypeWarning, ErrorReason(err), "Error delivering comment: %s", err.Error())
+		return err
+	}
+
+	// If the comment is up to date, we exit with no error
+	if reflect.DeepEqual(delivered, status) {
+		klog.V(4).InfoS(kind+" is up to date", logKey, klog.KObj(obj), "resourceVersion", obj.GetResourceVersion())
+		return nil
+	}
+
+	klog.InfoS("Delivered comment", logKey, klog.KObj(obj), "resourceVersion", obj.GetResourceVersion(), "url", delivered.URL)
+	klog.V(5).InfoS("Delivered message", logKey, klog.KObj(obj), "message", spec.Message)
+
+	if status.Created {
+		c.recorder.Eventf(obj, corev1.EventTypeNormal, "Updated", "Edited comment %s", delivered.URL)
+	} else {
+		c.recorder.Eventf(obj, corev1.EventTypeNormal, "Posted", "Posted comment %s", delivered.URL)
+	}
+
+	// mark it as created
+	if err := save(delivered); err != nil {
+		return fmt.Errorf("error saving update to %s resource: %w", kind, err)
+	}
+	klog.V(2).InfoS("Saved status of "+kind, logKey, klog.KObj(obj))
+	return nil
+}
+
+// ValidateSpec returns what is wrong with 'spec', if anything. The issue is
+// either given in full or not at all, to post on the default issue.
+func ValidateSpec(spec v1.CommentSpec) error {
+	if spec.Observed {
+		// mirrored from Github as they are.
+		return nil
+	}
+	if strings.TrimSpace(spec.Message) == "" {
+		return fmt.Errorf("message must not be empty")
+	}
+	if spec.Owner == "" && spec.Repository == "" && spec.Number == 0 {
+		return nil
+	}
+	if spec.Owner == "" || spec.Repository == "" || spec.Number <= 0 {
+		return fmt.Errorf("owner, repository and number must be set together, got %q, %q and %d", spec.Owner, spec.Repository, spec.Number)
+	}
+	return nil
+}
+
+// Deliver posts the message of 'spec' through 'gh' unless 'status' says it
+// was posted already, and edits the posted comment if the message changed
+// since. It returns the updated status. Errors of the Github API are
+// returned as they are, so that callers can tell rate limits apart.
+func Deliver(ctx context.Context, gh GitHub, spec v1.CommentSpec, status v1.CommentStatus) (v1.CommentStatus, error) {
+	owner, repo, number := spec.Owner, spec.Repository, spec.Number
+	if owner == "" && repo == "" && number == 0 {
+		issue := DefaultIssue()
+		owner, repo, number = issue.Owner, issue.Repository, issue.Number
+	}
+	hash := MessageHash(spec.Message)
+
+	// comments sent before their ID was recorded, or deleted on Github, can
+	// not be edited.
+	switch {
+	case spec.Observed:
+		return status, nil
+	case !status.Created:
+		comment, err := gh.CreateComment(ctx, owner, repo, number, spec.Message)
+		if err != nil {
+			return status, err
+		}
+		status.Created = true
+		status.CommentID = comment.GetID()
+		status.URL = comment.GetHTMLURL()
+	case status.CommentID != 0 && !status.RemoteDeleted && status.MessageHash != hash:
+		if _, err := gh.EditComment(ctx, owner, repo, status.CommentID, spec.Message); err != nil {
+			return status, err
+		}
+	default:
+		return status, nil
+	}
+	status.MessageHash = hash
+	return status, nil
+}
+
+// MessageHash returns the hash of 'message' recorded in CommentStatus.
+func MessageHash(message string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(message)))
+}
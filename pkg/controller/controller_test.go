@@ -0,0 +1,259 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/github"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	"github.com/nikhita/kube-custom-controller/pkg/client/fake"
+	factory "github.com/nikhita/kube-custom-controller/pkg/informers/externalversions"
+)
+
+// fakeGitHub records the comments created and edited through it, as
+// 'owner/repo#number: body' and 'owner/repo id: body'.
+type fakeGitHub struct {
+	err     error
+	created []string
+	edited  []string
+}
+
+func (f *fakeGitHub) CreateComment(ctx context.Context, owner, repo string, number int, body string) (*github.IssueComment, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.created = append(f.created, fmt.Sprintf("%s/%s#%d: %s", owner, repo, number, body))
+	id := int64(len(f.created))
+	url := fmt.Sprintf("https://github.com/%s/%s/issues/%d#issuecomment-%d", owner, repo, number, id)
+	return &github.IssueComment{ID: &id, HTMLURL: &url}, nil
+}
+
+func (f *fakeGitHub) EditComment(ctx context.Context, owner, repo string, id int64, body string) (*github.IssueComment, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.edited = append(f.edited, fmt.Sprintf("%s/%s %d: %s", owner, repo, id, body))
+	return &github.IssueComment{ID: &id, Body: &body}, nil
+}
+
+func TestValidateSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    v1.CommentSpec
+		wantErr bool
+	}{
+		{"default issue", v1.CommentSpec{Message: "hello"}, false},
+		{"full issue", v1.CommentSpec{Message: "hello", Owner: "nikhita", Repository: "website", Number: 1}, false},
+		{"empty message", v1.CommentSpec{Message: " \n"}, true},
+		{"owner only", v1.CommentSpec{Message: "hello", Owner: "nikhita"}, true},
+		{"no number", v1.CommentSpec{Message: "hello", Owner: "nikhita", Repository: "website"}, true},
+		{"negative number", v1.CommentSpec{Message: "hello", Owner: "nikhita", Repository: "website", Number: -1}, true},
+		{"observed", v1.CommentSpec{Observed: true}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateSpec(test.spec)
+			if (err != nil) != test.wantErr {
+				t.Errorf("expected error %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	spec := v1.CommentSpec{Message: "hello", Owner: "nikhita", Repository: "website", Number: 1}
+	posted := v1.CommentStatus{Created: true, CommentID: 7, URL: "https://github.com/nikhita/website/issues/1#issuecomment-7", MessageHash: MessageHash("hello")}
+	edited := posted
+	edited.MessageHash = MessageHash("hi")
+	deleted := edited
+	deleted.RemoteDeleted = true
+	issue := DefaultIssue()
+
+	tests := []struct {
+		name        string
+		spec        v1.CommentSpec
+		status      v1.CommentStatus
+		githubErr   error
+		wantStatus  v1.CommentStatus
+		wantCreated []string
+		wantEdited  []string
+		wantErr     bool
+	}{
+		{
+			name:        "create",
+			spec:        spec,
+			wantStatus:  v1.CommentStatus{Created: true, CommentID: 1, URL: "https://github.com/nikhita/website/issues/1#issuecomment-1", MessageHash: MessageHash("hello")},
+			wantCreated: []string{"nikhita/website#1: hello"},
+		},
+		{
+			name:        "create on the default issue",
+			spec:        v1.CommentSpec{Message: "hello"},
+			wantStatus:  v1.CommentStatus{Created: true, CommentID: 1, URL: fmt.Sprintf("https://github.com/%s/%s/issues/%d#issuecomment-1", issue.Owner, issue.Repository, issue.Number), MessageHash: MessageHash("hello")},
+			wantCreated: []string{fmt.Sprintf("%s/%s#%d: hello", issue.Owner, issue.Repository, issue.Number)},
+		},
+		{
+			name:       "edit",
+			spec:       spec,
+			status:     edited,
+			wantStatus: posted,
+			wantEdited: []string{"nikhita/website 7: hello"},
+		},
+		{
+			name:       "unchanged",
+			spec:       spec,
+			status:     posted,
+			wantStatus: posted,
+		},
+		{
+			name:       "observed",
+			spec:       v1.CommentSpec{Message: "hello", Observed: true},
+			status:     edited,
+			wantStatus: edited,
+		},
+		{
+			name:       "remote deleted",
+			spec:       spec,
+			status:     deleted,
+			wantStatus: deleted,
+		},
+		{
+			name:       "github error",
+			spec:       spec,
+			githubErr:  fmt.Errorf("unavailable"),
+			wantStatus: v1.CommentStatus{},
+			wantErr:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gh := &fakeGitHub{err: test.githubErr}
+			status, err := Deliver(context.Background(), gh, test.spec, test.status)
+			if (err != nil) != test.wantErr {
+				t.Errorf("expected error %v, got %v", test.wantErr, err)
+			}
+			if !reflect.DeepEqual(status, test.wantStatus) {
+				t.Errorf("expected status %+v, got %+v", test.wantStatus, status)
+			}
+			if !reflect.DeepEqual(gh.created, test.wantCreated) {
+				t.Errorf("expected comments %q to be created, got %q", test.wantCreated, gh.created)
+			}
+			if !reflect.DeepEqual(gh.edited, test.wantEdited) {
+				t.Errorf("expected comments %q to be edited, got %q", test.wantEdited, gh.edited)
+			}
+		})
+	}
+}
+
+func TestSync(t *testing.T) {
+	spec := v1.CommentSpec{Message: "hello", Owner: "nikhita", Repository: "website", Number: 1}
+	posted := v1.CommentStatus{Created: true, CommentID: 1, URL: "https://github.com/nikhita/website/issues/1#issuecomment-1", MessageHash: MessageHash("hello")}
+
+	tests := []struct {
+		name       string
+		spec       v1.CommentSpec
+		status     v1.CommentStatus
+		githubErr  error
+		wantStatus v1.CommentStatus
+		wantEvents []string
+		wantErr    bool
+	}{
+		{
+			name:       "posted",
+			spec:       spec,
+			wantStatus: posted,
+			wantEvents: []string{"Normal Posted Posted comment " + posted.URL},
+		},
+		{
+			name:       "updated",
+			spec:       v1.CommentSpec{Message: "hi", Owner: "nikhita", Repository: "website", Number: 1},
+			status:     posted,
+			wantStatus: v1.CommentStatus{Created: true, CommentID: 1, URL: posted.URL, MessageHash: MessageHash("hi")},
+			wantEvents: []string{"Normal Updated Edited comment " + posted.URL},
+		},
+		{
+			name:       "up to date",
+			spec:       spec,
+			status:     posted,
+			wantStatus: posted,
+		},
+		{
+			name:       "invalid spec",
+			spec:       v1.CommentSpec{Message: "hello", Owner: "nikhita"},
+			wantEvents: []string{`Warning InvalidSpec owner, repository and number must be set together, got "nikhita", "" and 0`},
+		},
+		{
+			name:       "github error",
+			spec:       spec,
+			githubErr:  fmt.Errorf("unavailable"),
+			wantEvents: []string{"Warning GitHubError Error delivering comment: unavailable"},
+			wantErr:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			comment := &v1.Comment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "hello"},
+				Spec:       test.spec,
+				Status:     test.status,
+			}
+			client := fake.NewSimpleClientset(comment)
+			recorder := record.NewFakeRecorder(10)
+			c := New(client, factory.NewSharedInformerFactory(client, 0), &fakeGitHub{err: test.githubErr}, recorder, nil)
+
+			err := c.Sync(context.Background(), comment.DeepCopy())
+			if (err != nil) != test.wantErr {
+				t.Errorf("expected error %v, got %v", test.wantErr, err)
+			}
+
+			saved, err := client.GithubV1().Comments("default").Get("hello", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("error getting Comment: %v", err)
+			}
+			if !reflect.DeepEqual(saved.Status, test.wantStatus) {
+				t.Errorf("expected status %+v, got %+v", test.wantStatus, saved.Status)
+			}
+
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			if !reflect.DeepEqual(events, test.wantEvents) {
+				t.Errorf("expected events %q, got %q", test.wantEvents, events)
+			}
+		})
+	}
+}
+
+func TestSyncClusterComment(t *testing.T) {
+	comment := &v1.ClusterComment{
+		ObjectMeta: metav1.ObjectMeta{Name: "announcement"},
+		Spec:       v1.CommentSpec{Message: "hello", Owner: "nikhita", Repository: "website", Number: 1},
+	}
+	client := fake.NewSimpleClientset(comment)
+	recorder := record.NewFakeRecorder(10)
+	informers := factory.NewSharedInformerFactory(client, 0)
+	c := New(client, informers, &fakeGitHub{}, recorder, nil)
+	c.WatchClusterComments(informers)
+
+	if err := c.SyncClusterComment(context.Background(), comment.DeepCopy()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	saved, err := client.GithubV1().ClusterComments().Get("announcement", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("error getting ClusterComment: %v", err)
+	}
+	want := v1.CommentStatus{Created: true, CommentID: 1, URL: "https://github.com/nikhita/website/issues/1#issuecomment-1", MessageHash: MessageHash("hello")}
+	if !reflect.DeepEqual(saved.Status, want) {
+		t.Errorf("expected status %+v, got %+v", want, saved.Status)
+	}
+	if event := <-recorder.Events; event != "Normal Posted Posted comment "+want.URL {
+		t.Errorf("expected a Posted event, got %q", event)
+	}
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/go-github/github"
+)
+
+// GitHub is the part of the Github API the controller uses. It is an
+// interface so that tests and other binaries can provide their own.
+type GitHub interface {
+	// CreateComment posts 'body' as a comment on issue 'number' of
+	// 'owner/repo'.
+	CreateComment(ctx context.Context, owner, repo string, number int, body string) (*github.IssueComment, error)
+	// EditComment replaces the body of the comment 'id' in 'owner/repo'.
+	EditComment(ctx context.Context, owner, repo string, id int64, body string) (*github.IssueComment, error)
+}
+
+// NewGitHub returns the GitHub backed by 'client'.
+func NewGitHub(client *github.Client) GitHub {
+	return &githubClient{client: client}
+}
+
+type githubClient struct {
+	client *github.Client
+}
+
+func (c *githubClient) CreateComment(ctx context.Context, owner, repo string, number int, body string) (*github.IssueComment, error) {
+	comment, _, err := c.client.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{Body: &body})
+	return comment, err
+}
+
+func (c *githubClient) EditComment(ctx context.Context, owner, repo string, id int64, body string) (*github.IssueComment, error) {
+	comment, _, err := c.client.Issues.EditComment(ctx, owner, repo, id, &github.IssueComment{Body: &body})
+	return comment, err
+}
+
+// ErrorReason returns the reason of the Warning Event recorded for the Github
+// API error 'err', which may be wrapped.
+func ErrorReason(err error) string {
+	var rateLimit *github.RateLimitError
+	var abuseRateLimit *github.AbuseRateLimitError
+	if errors.As(err, &rateLimit) || errors.As(err, &abuseRateLimit) {
+		return "RateLimited"
+	}
+	return "GitHubError"
+}
+
+// RequestID returns the ID Github assigned to the request that failed with
+// 'err', or an empty string if it does not wrap a Github API error. It is
+// logged so that failures can be matched to the requests in Github support
+// tickets.
+func RequestID(err error) string {
+	var resp *http.Response
+	var errorResponse *github.ErrorResponse
+	var rateLimit *github.RateLimitError
+	var abuseRateLimit *github.AbuseRateLimitError
+	switch {
+	case errors.As(err, &errorResponse):
+		resp = errorResponse.Response
+	case errors.As(err, &rateLimit):
+		resp = rateLimit.Response
+	case errors.As(err, &abuseRateLimit):
+		resp = abuseRateLimit.Response
+	}
+	if resp == nil {
+		return ""
+	}
+	return resp.Header.Get("X-GitHub-Request-Id")
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+func TestRequestID(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"X-Github-Request-Id": []string{"ABCD:1234"}}}
+
+	tests := []struct {
+		name       string
+		err        error
+		wantID     string
+		wantReason string
+	}{
+		{"error response", &github.ErrorResponse{Response: resp}, "ABCD:1234", "GitHubError"},
+		{"wrapped error response", fmt.Errorf("error getting pull request: %w", &github.ErrorResponse{Response: resp}), "ABCD:1234", "GitHubError"},
+		{"wrapped rate limit", fmt.Errorf("error editing comment: %w", &github.RateLimitError{Response: resp}), "ABCD:1234", "RateLimited"},
+		{"abuse rate limit", &github.AbuseRateLimitError{Response: resp}, "ABCD:1234", "RateLimited"},
+		{"other error", fmt.Errorf("connection refused"), "", "GitHubError"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if id := RequestID(test.err); id != test.wantID {
+				t.Errorf("expected request ID %q, got %q", test.wantID, id)
+			}
+			if reason := ErrorReason(test.err); reason != test.wantReason {
+				t.Errorf("expected reason %q, got %q", test.wantReason, reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+// Instrument wraps every attempt of the controller 'controller' to sync an
+// object, e.g. to export metrics or to detect stuck workers. It must call
+// 'sync' and return its error.
+type Instrument func(controller string, sync func() error) error
+
+// Work reads keys off 'queue' and hands them to 'process' until the queue is
+// shut down. 'name' names the controller in logs and for 'instrument', which
+// may be nil.
+func Work(name string, queue workqueue.RateLimitingInterface, process func(namespace, name string) error, instrument Instrument) {
+	if instrument == nil {
+		instrument = func(_ string, sync func() error) error {
+			return sync()
+		}
+	}
+
+	for {
+		// we read a message off the queue
+		key, shutdown := queue.Get()
+
+		// if the queue has been shut down, we should exit the work queue here
+		if shutdown {
+			return
+		}
+
+		// convert the queue item into a string. If it's not a string, we'll
+		// simply discard it as invalid data, log a message and move on to
+		// the next one.
+		var strKey string
+		var ok bool
+		if strKey, ok = key.(string); !ok {
+			runtime.HandleError(fmt.Errorf("key in queue should be of type string but got %T. discarding", key))
+			queue.Forget(key)
+			queue.Done(key)
+			continue
+		}
+
+		// we define a function here to process a queue item, so that we can
+		// use 'defer' to make sure the message is marked as Done on the queue
+		func(key string) {
+			defer queue.Done(key)
+
+			// attempt to split the 'key' into namespace and object name
+			namespace, objectName, err := cache.SplitMetaNamespaceKey(key)
+			if err != nil {
+				runtime.HandleError(fmt.Errorf("error splitting meta namespace key into parts: %w", err))
+				return
+			}
+
+			// the reconcile ID tells the attempts to sync the same object
+			// apart.
+			logger := klog.Background().WithValues("controller", name, "namespace", namespace, "name", objectName, "reconcileID", string(uuid.NewUUID()))
+			logger.V(4).Info("Read item off workqueue, processing")
+
+			// attempt to sync the current state of the world with the desired!
+			// If process returns an error, we skip calling `queue.Forget` and
+			// requeue the key, thus causing the resource to be retried after
+			// the backoff of the queue's rate limiter.
+			start := time.Now()
+			err = instrument(name, func() error {
+				return process(namespace, objectName)
+			})
+			if err != nil {
+				logger.Error(err, "Error processing item, requeuing", "githubRequestID", RequestID(err), "retries", queue.NumRequeues(key))
+				queue.AddRateLimited(key)
+				return
+			}
+
+			logger.V(2).Info("Finished processing item", "duration", time.Since(start))
+
+			// as we managed to process this successfully, we can forget it
+			// from the work queue altogether.
+			queue.Forget(key)
+		}(strKey)
+	}
+}
@@ -52,8 +52,24 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=Github, Version=V1
+	case v1.SchemeGroupVersion.WithResource("actionssecrets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().V1().ActionsSecrets().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("clustercomments"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().V1().ClusterComments().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("comments"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().V1().Comments().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("commentcampaigns"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().V1().CommentCampaigns().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("discussions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().V1().Discussions().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("notificationrules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().V1().NotificationRules().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("pullrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().V1().PullRequests().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("repositoryfiles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().V1().RepositoryFiles().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("workflowtriggers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().V1().WorkflowTriggers().Informer()}, nil
 
 	}
 
@@ -0,0 +1,87 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by informer-gen
+
+package v1
+
+import (
+	github_v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	client "github.com/nikhita/kube-custom-controller/pkg/client"
+	internalinterfaces "github.com/nikhita/kube-custom-controller/pkg/informers/externalversions/internalinterfaces"
+	v1 "github.com/nikhita/kube-custom-controller/pkg/listers/github/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+	time "time"
+)
+
+// ClusterCommentInformer provides access to a shared informer and lister for
+// ClusterComments.
+type ClusterCommentInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.ClusterCommentLister
+}
+
+type clusterCommentInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewClusterCommentInformer constructs a new informer for ClusterComment type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewClusterCommentInformer(client client.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredClusterCommentInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredClusterCommentInformer constructs a new informer for ClusterComment type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredClusterCommentInformer(client client.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options meta_v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.GithubV1().ClusterComments().List(options)
+			},
+			WatchFunc: func(options meta_v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.GithubV1().ClusterComments().Watch(options)
+			},
+		},
+		&github_v1.ClusterComment{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *clusterCommentInformer) defaultInformer(client client.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredClusterCommentInformer(client, resyncPeriod, cache.Indexers{}, f.tweakListOptions)
+}
+
+func (f *clusterCommentInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&github_v1.ClusterComment{}, f.defaultInformer)
+}
+
+func (f *clusterCommentInformer) Lister() v1.ClusterCommentLister {
+	return v1.NewClusterCommentLister(f.Informer().GetIndexer())
+}
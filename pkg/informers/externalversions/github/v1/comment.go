@@ -38,19 +38,34 @@ type CommentInformer interface {
 }
 
 type commentInformer struct {
-	factory internalinterfaces.SharedInformerFactory
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
 }
 
 // NewCommentInformer constructs a new informer for Comment type.
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
 func NewCommentInformer(client client.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredCommentInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredCommentInformer constructs a new informer for Comment type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredCommentInformer(client client.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
 	return cache.NewSharedIndexInformer(
 		&cache.ListWatch{
 			ListFunc: func(options meta_v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
 				return client.GithubV1().Comments(namespace).List(options)
 			},
 			WatchFunc: func(options meta_v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
 				return client.GithubV1().Comments(namespace).Watch(options)
 			},
 		},
@@ -60,12 +75,12 @@ func NewCommentInformer(client client.Interface, namespace string, resyncPeriod
 	)
 }
 
-func defaultCommentInformer(client client.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
-	return NewCommentInformer(client, meta_v1.NamespaceAll, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+func (f *commentInformer) defaultInformer(client client.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredCommentInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
 }
 
 func (f *commentInformer) Informer() cache.SharedIndexInformer {
-	return f.factory.InformerFor(&github_v1.Comment{}, defaultCommentInformer)
+	return f.factory.InformerFor(&github_v1.Comment{}, f.defaultInformer)
 }
 
 func (f *commentInformer) Lister() v1.CommentLister {
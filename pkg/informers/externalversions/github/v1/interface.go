@@ -24,20 +24,78 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ActionsSecrets returns a ActionsSecretInformer.
+	ActionsSecrets() ActionsSecretInformer
+	// ClusterComments returns a ClusterCommentInformer.
+	ClusterComments() ClusterCommentInformer
 	// Comments returns a CommentInformer.
 	Comments() CommentInformer
+	// CommentCampaigns returns a CommentCampaignInformer.
+	CommentCampaigns() CommentCampaignInformer
+	// Discussions returns a DiscussionInformer.
+	Discussions() DiscussionInformer
+	// NotificationRules returns a NotificationRuleInformer.
+	NotificationRules() NotificationRuleInformer
+	// PullRequests returns a PullRequestInformer.
+	PullRequests() PullRequestInformer
+	// RepositoryFiles returns a RepositoryFileInformer.
+	RepositoryFiles() RepositoryFileInformer
+	// WorkflowTriggers returns a WorkflowTriggerInformer.
+	WorkflowTriggers() WorkflowTriggerInformer
 }
 
 type version struct {
-	internalinterfaces.SharedInformerFactory
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
 }
 
 // New returns a new Interface.
-func New(f internalinterfaces.SharedInformerFactory) Interface {
-	return &version{f}
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// ActionsSecrets returns a ActionsSecretInformer.
+func (v *version) ActionsSecrets() ActionsSecretInformer {
+	return &actionsSecretInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ClusterComments returns a ClusterCommentInformer.
+func (v *version) ClusterComments() ClusterCommentInformer {
+	return &clusterCommentInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
 // Comments returns a CommentInformer.
 func (v *version) Comments() CommentInformer {
-	return &commentInformer{factory: v.SharedInformerFactory}
+	return &commentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// CommentCampaigns returns a CommentCampaignInformer.
+func (v *version) CommentCampaigns() CommentCampaignInformer {
+	return &commentCampaignInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Discussions returns a DiscussionInformer.
+func (v *version) Discussions() DiscussionInformer {
+	return &discussionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// NotificationRules returns a NotificationRuleInformer.
+func (v *version) NotificationRules() NotificationRuleInformer {
+	return &notificationRuleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// PullRequests returns a PullRequestInformer.
+func (v *version) PullRequests() PullRequestInformer {
+	return &pullRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// RepositoryFiles returns a RepositoryFileInformer.
+func (v *version) RepositoryFiles() RepositoryFileInformer {
+	return &repositoryFileInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// WorkflowTriggers returns a WorkflowTriggerInformer.
+func (v *version) WorkflowTriggers() WorkflowTriggerInformer {
+	return &workflowTriggerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
@@ -52,8 +52,24 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=Github, Version=InternalVersion
+	case github.SchemeGroupVersion.WithResource("actionssecrets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().InternalVersion().ActionsSecrets().Informer()}, nil
+	case github.SchemeGroupVersion.WithResource("clustercomments"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().InternalVersion().ClusterComments().Informer()}, nil
 	case github.SchemeGroupVersion.WithResource("comments"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().InternalVersion().Comments().Informer()}, nil
+	case github.SchemeGroupVersion.WithResource("commentcampaigns"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().InternalVersion().CommentCampaigns().Informer()}, nil
+	case github.SchemeGroupVersion.WithResource("discussions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().InternalVersion().Discussions().Informer()}, nil
+	case github.SchemeGroupVersion.WithResource("notificationrules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().InternalVersion().NotificationRules().Informer()}, nil
+	case github.SchemeGroupVersion.WithResource("pullrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().InternalVersion().PullRequests().Informer()}, nil
+	case github.SchemeGroupVersion.WithResource("repositoryfiles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().InternalVersion().RepositoryFiles().Informer()}, nil
+	case github.SchemeGroupVersion.WithResource("workflowtriggers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Github().InternalVersion().WorkflowTriggers().Informer()}, nil
 
 	}
 
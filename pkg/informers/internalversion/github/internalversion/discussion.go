@@ -0,0 +1,73 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by informer-gen
+
+package internalversion
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	internalclientset "github.com/nikhita/kube-custom-controller/pkg/client/internalclientset"
+	internalinterfaces "github.com/nikhita/kube-custom-controller/pkg/informers/internalversion/internalinterfaces"
+	internalversion "github.com/nikhita/kube-custom-controller/pkg/listers/github/internalversion"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+	time "time"
+)
+
+// DiscussionInformer provides access to a shared informer and lister for
+// Discussions.
+type DiscussionInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() internalversion.DiscussionLister
+}
+
+type discussionInformer struct {
+	factory internalinterfaces.SharedInformerFactory
+}
+
+// NewDiscussionInformer constructs a new informer for Discussion type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewDiscussionInformer(client internalclientset.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				return client.Github().Discussions(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				return client.Github().Discussions(namespace).Watch(options)
+			},
+		},
+		&github.Discussion{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func defaultDiscussionInformer(client internalclientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewDiscussionInformer(client, v1.NamespaceAll, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+}
+
+func (f *discussionInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&github.Discussion{}, defaultDiscussionInformer)
+}
+
+func (f *discussionInformer) Lister() internalversion.DiscussionLister {
+	return internalversion.NewDiscussionLister(f.Informer().GetIndexer())
+}
@@ -24,8 +24,24 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ActionsSecrets returns a ActionsSecretInformer.
+	ActionsSecrets() ActionsSecretInformer
+	// ClusterComments returns a ClusterCommentInformer.
+	ClusterComments() ClusterCommentInformer
 	// Comments returns a CommentInformer.
 	Comments() CommentInformer
+	// CommentCampaigns returns a CommentCampaignInformer.
+	CommentCampaigns() CommentCampaignInformer
+	// Discussions returns a DiscussionInformer.
+	Discussions() DiscussionInformer
+	// NotificationRules returns a NotificationRuleInformer.
+	NotificationRules() NotificationRuleInformer
+	// PullRequests returns a PullRequestInformer.
+	PullRequests() PullRequestInformer
+	// RepositoryFiles returns a RepositoryFileInformer.
+	RepositoryFiles() RepositoryFileInformer
+	// WorkflowTriggers returns a WorkflowTriggerInformer.
+	WorkflowTriggers() WorkflowTriggerInformer
 }
 
 type version struct {
@@ -37,7 +53,47 @@ func New(f internalinterfaces.SharedInformerFactory) Interface {
 	return &version{f}
 }
 
+// ActionsSecrets returns a ActionsSecretInformer.
+func (v *version) ActionsSecrets() ActionsSecretInformer {
+	return &actionsSecretInformer{factory: v.SharedInformerFactory}
+}
+
+// ClusterComments returns a ClusterCommentInformer.
+func (v *version) ClusterComments() ClusterCommentInformer {
+	return &clusterCommentInformer{factory: v.SharedInformerFactory}
+}
+
 // Comments returns a CommentInformer.
 func (v *version) Comments() CommentInformer {
 	return &commentInformer{factory: v.SharedInformerFactory}
 }
+
+// CommentCampaigns returns a CommentCampaignInformer.
+func (v *version) CommentCampaigns() CommentCampaignInformer {
+	return &commentCampaignInformer{factory: v.SharedInformerFactory}
+}
+
+// Discussions returns a DiscussionInformer.
+func (v *version) Discussions() DiscussionInformer {
+	return &discussionInformer{factory: v.SharedInformerFactory}
+}
+
+// NotificationRules returns a NotificationRuleInformer.
+func (v *version) NotificationRules() NotificationRuleInformer {
+	return &notificationRuleInformer{factory: v.SharedInformerFactory}
+}
+
+// PullRequests returns a PullRequestInformer.
+func (v *version) PullRequests() PullRequestInformer {
+	return &pullRequestInformer{factory: v.SharedInformerFactory}
+}
+
+// RepositoryFiles returns a RepositoryFileInformer.
+func (v *version) RepositoryFiles() RepositoryFileInformer {
+	return &repositoryFileInformer{factory: v.SharedInformerFactory}
+}
+
+// WorkflowTriggers returns a WorkflowTriggerInformer.
+func (v *version) WorkflowTriggers() WorkflowTriggerInformer {
+	return &workflowTriggerInformer{factory: v.SharedInformerFactory}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by lister-gen
+
+package internalversion
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ActionsSecretLister helps list ActionsSecrets.
+type ActionsSecretLister interface {
+	// List lists all ActionsSecrets in the indexer.
+	List(selector labels.Selector) (ret []*github.ActionsSecret, err error)
+	// ActionsSecrets returns an object that can list and get ActionsSecrets.
+	ActionsSecrets(namespace string) ActionsSecretNamespaceLister
+	ActionsSecretListerExpansion
+}
+
+// actionsSecretLister implements the ActionsSecretLister interface.
+type actionsSecretLister struct {
+	indexer cache.Indexer
+}
+
+// NewActionsSecretLister returns a new ActionsSecretLister.
+func NewActionsSecretLister(indexer cache.Indexer) ActionsSecretLister {
+	return &actionsSecretLister{indexer: indexer}
+}
+
+// List lists all ActionsSecrets in the indexer.
+func (s *actionsSecretLister) List(selector labels.Selector) (ret []*github.ActionsSecret, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*github.ActionsSecret))
+	})
+	return ret, err
+}
+
+// ActionsSecrets returns an object that can list and get ActionsSecrets.
+func (s *actionsSecretLister) ActionsSecrets(namespace string) ActionsSecretNamespaceLister {
+	return actionsSecretNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ActionsSecretNamespaceLister helps list and get ActionsSecrets.
+type ActionsSecretNamespaceLister interface {
+	// List lists all ActionsSecrets in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*github.ActionsSecret, err error)
+	// Get retrieves the ActionsSecret from the indexer for a given namespace and name.
+	Get(name string) (*github.ActionsSecret, error)
+	ActionsSecretNamespaceListerExpansion
+}
+
+// actionsSecretNamespaceLister implements the ActionsSecretNamespaceLister
+// interface.
+type actionsSecretNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ActionsSecrets in the indexer for a given namespace.
+func (s actionsSecretNamespaceLister) List(selector labels.Selector) (ret []*github.ActionsSecret, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*github.ActionsSecret))
+	})
+	return ret, err
+}
+
+// Get retrieves the ActionsSecret from the indexer for a given namespace and name.
+func (s actionsSecretNamespaceLister) Get(name string) (*github.ActionsSecret, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(github.Resource("actionssecret"), name)
+	}
+	return obj.(*github.ActionsSecret), nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by lister-gen
+
+package internalversion
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ClusterCommentLister helps list ClusterComments.
+type ClusterCommentLister interface {
+	// List lists all ClusterComments in the indexer.
+	List(selector labels.Selector) (ret []*github.ClusterComment, err error)
+	// Get retrieves the ClusterComment from the index for a given name.
+	Get(name string) (*github.ClusterComment, error)
+	ClusterCommentListerExpansion
+}
+
+// clusterCommentLister implements the ClusterCommentLister interface.
+type clusterCommentLister struct {
+	indexer cache.Indexer
+}
+
+// NewClusterCommentLister returns a new ClusterCommentLister.
+func NewClusterCommentLister(indexer cache.Indexer) ClusterCommentLister {
+	return &clusterCommentLister{indexer: indexer}
+}
+
+// List lists all ClusterComments in the indexer.
+func (s *clusterCommentLister) List(selector labels.Selector) (ret []*github.ClusterComment, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*github.ClusterComment))
+	})
+	return ret, err
+}
+
+// Get retrieves the ClusterComment from the index for a given name.
+func (s *clusterCommentLister) Get(name string) (*github.ClusterComment, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(github.Resource("clustercomment"), name)
+	}
+	return obj.(*github.ClusterComment), nil
+}
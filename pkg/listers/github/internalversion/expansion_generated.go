@@ -18,6 +18,18 @@ limitations under the License.
 
 package internalversion
 
+// ActionsSecretListerExpansion allows custom methods to be added to
+// ActionsSecretLister.
+type ActionsSecretListerExpansion interface{}
+
+// ActionsSecretNamespaceListerExpansion allows custom methods to be added to
+// ActionsSecretNamespaceLister.
+type ActionsSecretNamespaceListerExpansion interface{}
+
+// ClusterCommentListerExpansion allows custom methods to be added to
+// ClusterCommentLister.
+type ClusterCommentListerExpansion interface{}
+
 // CommentListerExpansion allows custom methods to be added to
 // CommentLister.
 type CommentListerExpansion interface{}
@@ -25,3 +37,51 @@ type CommentListerExpansion interface{}
 // CommentNamespaceListerExpansion allows custom methods to be added to
 // CommentNamespaceLister.
 type CommentNamespaceListerExpansion interface{}
+
+// CommentCampaignListerExpansion allows custom methods to be added to
+// CommentCampaignLister.
+type CommentCampaignListerExpansion interface{}
+
+// CommentCampaignNamespaceListerExpansion allows custom methods to be added to
+// CommentCampaignNamespaceLister.
+type CommentCampaignNamespaceListerExpansion interface{}
+
+// DiscussionListerExpansion allows custom methods to be added to
+// DiscussionLister.
+type DiscussionListerExpansion interface{}
+
+// DiscussionNamespaceListerExpansion allows custom methods to be added to
+// DiscussionNamespaceLister.
+type DiscussionNamespaceListerExpansion interface{}
+
+// NotificationRuleListerExpansion allows custom methods to be added to
+// NotificationRuleLister.
+type NotificationRuleListerExpansion interface{}
+
+// NotificationRuleNamespaceListerExpansion allows custom methods to be added to
+// NotificationRuleNamespaceLister.
+type NotificationRuleNamespaceListerExpansion interface{}
+
+// PullRequestListerExpansion allows custom methods to be added to
+// PullRequestLister.
+type PullRequestListerExpansion interface{}
+
+// PullRequestNamespaceListerExpansion allows custom methods to be added to
+// PullRequestNamespaceLister.
+type PullRequestNamespaceListerExpansion interface{}
+
+// RepositoryFileListerExpansion allows custom methods to be added to
+// RepositoryFileLister.
+type RepositoryFileListerExpansion interface{}
+
+// RepositoryFileNamespaceListerExpansion allows custom methods to be added to
+// RepositoryFileNamespaceLister.
+type RepositoryFileNamespaceListerExpansion interface{}
+
+// WorkflowTriggerListerExpansion allows custom methods to be added to
+// WorkflowTriggerLister.
+type WorkflowTriggerListerExpansion interface{}
+
+// WorkflowTriggerNamespaceListerExpansion allows custom methods to be added to
+// WorkflowTriggerNamespaceLister.
+type WorkflowTriggerNamespaceListerExpansion interface{}
@@ -0,0 +1,94 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by lister-gen
+
+package internalversion
+
+import (
+	github "github.com/nikhita/kube-custom-controller/pkg/apis/github"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// PullRequestLister helps list PullRequests.
+type PullRequestLister interface {
+	// List lists all PullRequests in the indexer.
+	List(selector labels.Selector) (ret []*github.PullRequest, err error)
+	// PullRequests returns an object that can list and get PullRequests.
+	PullRequests(namespace string) PullRequestNamespaceLister
+	PullRequestListerExpansion
+}
+
+// pullRequestLister implements the PullRequestLister interface.
+type pullRequestLister struct {
+	indexer cache.Indexer
+}
+
+// NewPullRequestLister returns a new PullRequestLister.
+func NewPullRequestLister(indexer cache.Indexer) PullRequestLister {
+	return &pullRequestLister{indexer: indexer}
+}
+
+// List lists all PullRequests in the indexer.
+func (s *pullRequestLister) List(selector labels.Selector) (ret []*github.PullRequest, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*github.PullRequest))
+	})
+	return ret, err
+}
+
+// PullRequests returns an object that can list and get PullRequests.
+func (s *pullRequestLister) PullRequests(namespace string) PullRequestNamespaceLister {
+	return pullRequestNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// PullRequestNamespaceLister helps list and get PullRequests.
+type PullRequestNamespaceLister interface {
+	// List lists all PullRequests in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*github.PullRequest, err error)
+	// Get retrieves the PullRequest from the indexer for a given namespace and name.
+	Get(name string) (*github.PullRequest, error)
+	PullRequestNamespaceListerExpansion
+}
+
+// pullRequestNamespaceLister implements the PullRequestNamespaceLister
+// interface.
+type pullRequestNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all PullRequests in the indexer for a given namespace.
+func (s pullRequestNamespaceLister) List(selector labels.Selector) (ret []*github.PullRequest, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*github.PullRequest))
+	})
+	return ret, err
+}
+
+// Get retrieves the PullRequest from the indexer for a given namespace and name.
+func (s pullRequestNamespaceLister) Get(name string) (*github.PullRequest, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(github.Resource("pullrequest"), name)
+	}
+	return obj.(*github.PullRequest), nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by lister-gen
+
+package v1
+
+import (
+	v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// CommentCampaignLister helps list CommentCampaigns.
+type CommentCampaignLister interface {
+	// List lists all CommentCampaigns in the indexer.
+	List(selector labels.Selector) (ret []*v1.CommentCampaign, err error)
+	// CommentCampaigns returns an object that can list and get CommentCampaigns.
+	CommentCampaigns(namespace string) CommentCampaignNamespaceLister
+	CommentCampaignListerExpansion
+}
+
+// commentCampaignLister implements the CommentCampaignLister interface.
+type commentCampaignLister struct {
+	indexer cache.Indexer
+}
+
+// NewCommentCampaignLister returns a new CommentCampaignLister.
+func NewCommentCampaignLister(indexer cache.Indexer) CommentCampaignLister {
+	return &commentCampaignLister{indexer: indexer}
+}
+
+// List lists all CommentCampaigns in the indexer.
+func (s *commentCampaignLister) List(selector labels.Selector) (ret []*v1.CommentCampaign, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.CommentCampaign))
+	})
+	return ret, err
+}
+
+// CommentCampaigns returns an object that can list and get CommentCampaigns.
+func (s *commentCampaignLister) CommentCampaigns(namespace string) CommentCampaignNamespaceLister {
+	return commentCampaignNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// CommentCampaignNamespaceLister helps list and get CommentCampaigns.
+type CommentCampaignNamespaceLister interface {
+	// List lists all CommentCampaigns in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1.CommentCampaign, err error)
+	// Get retrieves the CommentCampaign from the indexer for a given namespace and name.
+	Get(name string) (*v1.CommentCampaign, error)
+	CommentCampaignNamespaceListerExpansion
+}
+
+// commentCampaignNamespaceLister implements the CommentCampaignNamespaceLister
+// interface.
+type commentCampaignNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all CommentCampaigns in the indexer for a given namespace.
+func (s commentCampaignNamespaceLister) List(selector labels.Selector) (ret []*v1.CommentCampaign, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.CommentCampaign))
+	})
+	return ret, err
+}
+
+// Get retrieves the CommentCampaign from the indexer for a given namespace and name.
+func (s commentCampaignNamespaceLister) Get(name string) (*v1.CommentCampaign, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("commentcampaign"), name)
+	}
+	return obj.(*v1.CommentCampaign), nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by lister-gen
+
+package v1
+
+import (
+	v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DiscussionLister helps list Discussions.
+type DiscussionLister interface {
+	// List lists all Discussions in the indexer.
+	List(selector labels.Selector) (ret []*v1.Discussion, err error)
+	// Discussions returns an object that can list and get Discussions.
+	Discussions(namespace string) DiscussionNamespaceLister
+	DiscussionListerExpansion
+}
+
+// discussionLister implements the DiscussionLister interface.
+type discussionLister struct {
+	indexer cache.Indexer
+}
+
+// NewDiscussionLister returns a new DiscussionLister.
+func NewDiscussionLister(indexer cache.Indexer) DiscussionLister {
+	return &discussionLister{indexer: indexer}
+}
+
+// List lists all Discussions in the indexer.
+func (s *discussionLister) List(selector labels.Selector) (ret []*v1.Discussion, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.Discussion))
+	})
+	return ret, err
+}
+
+// Discussions returns an object that can list and get Discussions.
+func (s *discussionLister) Discussions(namespace string) DiscussionNamespaceLister {
+	return discussionNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DiscussionNamespaceLister helps list and get Discussions.
+type DiscussionNamespaceLister interface {
+	// List lists all Discussions in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1.Discussion, err error)
+	// Get retrieves the Discussion from the indexer for a given namespace and name.
+	Get(name string) (*v1.Discussion, error)
+	DiscussionNamespaceListerExpansion
+}
+
+// discussionNamespaceLister implements the DiscussionNamespaceLister
+// interface.
+type discussionNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all Discussions in the indexer for a given namespace.
+func (s discussionNamespaceLister) List(selector labels.Selector) (ret []*v1.Discussion, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.Discussion))
+	})
+	return ret, err
+}
+
+// Get retrieves the Discussion from the indexer for a given namespace and name.
+func (s discussionNamespaceLister) Get(name string) (*v1.Discussion, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("discussion"), name)
+	}
+	return obj.(*v1.Discussion), nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by lister-gen
+
+package v1
+
+import (
+	v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RepositoryFileLister helps list RepositoryFiles.
+type RepositoryFileLister interface {
+	// List lists all RepositoryFiles in the indexer.
+	List(selector labels.Selector) (ret []*v1.RepositoryFile, err error)
+	// RepositoryFiles returns an object that can list and get RepositoryFiles.
+	RepositoryFiles(namespace string) RepositoryFileNamespaceLister
+	RepositoryFileListerExpansion
+}
+
+// repositoryFileLister implements the RepositoryFileLister interface.
+type repositoryFileLister struct {
+	indexer cache.Indexer
+}
+
+// NewRepositoryFileLister returns a new RepositoryFileLister.
+func NewRepositoryFileLister(indexer cache.Indexer) RepositoryFileLister {
+	return &repositoryFileLister{indexer: indexer}
+}
+
+// List lists all RepositoryFiles in the indexer.
+func (s *repositoryFileLister) List(selector labels.Selector) (ret []*v1.RepositoryFile, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RepositoryFile))
+	})
+	return ret, err
+}
+
+// RepositoryFiles returns an object that can list and get RepositoryFiles.
+func (s *repositoryFileLister) RepositoryFiles(namespace string) RepositoryFileNamespaceLister {
+	return repositoryFileNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RepositoryFileNamespaceLister helps list and get RepositoryFiles.
+type RepositoryFileNamespaceLister interface {
+	// List lists all RepositoryFiles in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1.RepositoryFile, err error)
+	// Get retrieves the RepositoryFile from the indexer for a given namespace and name.
+	Get(name string) (*v1.RepositoryFile, error)
+	RepositoryFileNamespaceListerExpansion
+}
+
+// repositoryFileNamespaceLister implements the RepositoryFileNamespaceLister
+// interface.
+type repositoryFileNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RepositoryFiles in the indexer for a given namespace.
+func (s repositoryFileNamespaceLister) List(selector labels.Selector) (ret []*v1.RepositoryFile, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RepositoryFile))
+	})
+	return ret, err
+}
+
+// Get retrieves the RepositoryFile from the indexer for a given namespace and name.
+func (s repositoryFileNamespaceLister) Get(name string) (*v1.RepositoryFile, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("repositoryfile"), name)
+	}
+	return obj.(*v1.RepositoryFile), nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by lister-gen
+
+package v1
+
+import (
+	v1 "github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WorkflowTriggerLister helps list WorkflowTriggers.
+type WorkflowTriggerLister interface {
+	// List lists all WorkflowTriggers in the indexer.
+	List(selector labels.Selector) (ret []*v1.WorkflowTrigger, err error)
+	// WorkflowTriggers returns an object that can list and get WorkflowTriggers.
+	WorkflowTriggers(namespace string) WorkflowTriggerNamespaceLister
+	WorkflowTriggerListerExpansion
+}
+
+// workflowTriggerLister implements the WorkflowTriggerLister interface.
+type workflowTriggerLister struct {
+	indexer cache.Indexer
+}
+
+// NewWorkflowTriggerLister returns a new WorkflowTriggerLister.
+func NewWorkflowTriggerLister(indexer cache.Indexer) WorkflowTriggerLister {
+	return &workflowTriggerLister{indexer: indexer}
+}
+
+// List lists all WorkflowTriggers in the indexer.
+func (s *workflowTriggerLister) List(selector labels.Selector) (ret []*v1.WorkflowTrigger, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.WorkflowTrigger))
+	})
+	return ret, err
+}
+
+// WorkflowTriggers returns an object that can list and get WorkflowTriggers.
+func (s *workflowTriggerLister) WorkflowTriggers(namespace string) WorkflowTriggerNamespaceLister {
+	return workflowTriggerNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// WorkflowTriggerNamespaceLister helps list and get WorkflowTriggers.
+type WorkflowTriggerNamespaceLister interface {
+	// List lists all WorkflowTriggers in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1.WorkflowTrigger, err error)
+	// Get retrieves the WorkflowTrigger from the indexer for a given namespace and name.
+	Get(name string) (*v1.WorkflowTrigger, error)
+	WorkflowTriggerNamespaceListerExpansion
+}
+
+// workflowTriggerNamespaceLister implements the WorkflowTriggerNamespaceLister
+// interface.
+type workflowTriggerNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all WorkflowTriggers in the indexer for a given namespace.
+func (s workflowTriggerNamespaceLister) List(selector labels.Selector) (ret []*v1.WorkflowTrigger, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.WorkflowTrigger))
+	})
+	return ret, err
+}
+
+// Get retrieves the WorkflowTrigger from the indexer for a given namespace and name.
+func (s workflowTriggerNamespaceLister) Get(name string) (*v1.WorkflowTrigger, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("workflowtrigger"), name)
+	}
+	return obj.(*v1.WorkflowTrigger), nil
+}
@@ -0,0 +1,392 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/google/go-github/github"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+)
+
+const (
+	// the labels preview namespaces are found by.
+	previewOwnerLabel      = "github.k8s.io/preview-owner"
+	previewRepositoryLabel = "github.k8s.io/preview-repository"
+	previewNumberLabel     = "github.k8s.io/preview-number"
+
+	// previewSHAAnnotation records the head SHA the manifests were last
+	// applied for.
+	previewSHAAnnotation = "github.k8s.io/preview-sha"
+
+	// previewCommentName is the name of the Comment posted in every preview
+	// namespace.
+	previewCommentName = "preview"
+)
+
+var (
+	// invalidNameChars are replaced in repository names to turn them into
+	// namespace names.
+	invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+	// documentSeparator splits YAML streams into documents.
+	documentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+	// validRef matches the head refs the manifests may be rendered with.
+	// Refs are chosen by whoever opens the pull request, other characters
+	// could break out of the YAML strings they are rendered into.
+	validRef = regexp.MustCompile(`^[A-Za-z0-9._/-]+$`)
+)
+
+var previewQueue = newQueue("previews")
+
+// previews holds the configuration of preview environments. It is nil when
+// they are disabled.
+var previews *previewConfig
+
+// previewConfig selects the repositories whose pull requests get preview
+// environments, and the manifests they are made of.
+type previewConfig struct {
+	repositories []string
+	label        string
+	interval     time.Duration
+
+	// manifests are the templates of the objects created in the namespace
+	// of every preview.
+	manifests []*template.Template
+	mapper    meta.ResettableRESTMapper
+}
+
+// previewData is what the manifests are rendered with.
+type previewData struct {
+	Owner      string
+	Repository string
+	Number     int
+	HeadSHA    string
+	Branch     string
+	Namespace  string
+}
+
+// newPreviews sets up preview environments for the comma separated list of
+// 'owner/repo' repositories 'repositories', from the manifests in the
+// directory 'dir'.
+func newPreviews(repositories, label, dir string, interval time.Duration) (*previewConfig, error) {
+	config := &previewConfig{
+		label:    label,
+		interval: interval,
+		mapper:   restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(kubeClient.Discovery())),
+	}
+	for _, repository := range strings.Split(repositories, ",") {
+		repository = strings.TrimSpace(repository)
+		if parts := strings.Split(repository, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid repository %q, expected owner/repo", repository)
+		}
+		config.repositories = append(config.repositories, repository)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.y*ml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no manifests found in %s", dir)
+	}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		tmpl, err := template.New(filepath.Base(file)).Parse(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", file, err)
+		}
+		config.manifests = append(config.manifests, tmpl)
+	}
+	return config, nil
+}
+
+// run enqueues every repository each interval until 'stopCh' is closed.
+// Pull request webhooks enqueue them in between.
+func (p *previewConfig) run(stopCh <-chan struct{}) {
+	wait.Until(p.requeue, p.interval, stopCh)
+}
+
+// requeue schedules a sync of every repository.
+func (p *previewConfig) requeue() {
+	for _, repository := range p.repositories {
+		previewQueue.Add(repository)
+	}
+}
+
+// enqueue schedules a sync of 'owner/repo' if it has preview environments.
+func (p *previewConfig) enqueue(owner, repo string) {
+	for _, repository := range p.repositories {
+		if repository == owner+"/"+repo {
+			previewQueue.Add(repository)
+		}
+	}
+}
+
+// processPreviews syncs the preview environments of the repository
+// 'owner/repo'. It creates or updates one for every open pull request with
+// the preview label, and tears down the others.
+func processPreviews(owner, repo string) error {
+	opts := &github.PullRequestListOptions{State: "open", ListOptions: github.ListOptions{PerPage: 100}}
+	wanted := map[int]*github.PullRequest{}
+	for {
+		prs, resp, err := githubClient.PullRequests.List(ctx, owner, repo, opts)
+		if err != nil {
+			return fmt.Errorf("error listing pull requests of %s/%s: %w", owner, repo, err)
+		}
+		for _, pr := range prs {
+			for _, label := range pr.Labels {
+				if label.GetName() == previews.label {
+					wanted[pr.GetNumber()] = pr
+				}
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	namespaces, err := kubeClient.CoreV1().Namespaces().List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,%s=%s", previewOwnerLabel, owner, previewRepositoryLabel, repo),
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []string
+	for i := range namespaces.Items {
+		namespace := &namespaces.Items[i]
+		// namespaces named differently were created before the names
+		// included the owner, and are replaced.
+		number, _ := strconv.Atoi(namespace.Labels[previewNumberLabel])
+		if _, ok := wanted[number]; (ok && namespace.Name == previewNamespace(owner, repo, number)) || namespace.DeletionTimestamp != nil {
+			continue
+		}
+		if err := previews.tearDown(namespace); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	for _, pr := range wanted {
+		if err := previews.sync(owner, repo, pr); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("error syncing previews of %s/%s: %s", owner, repo, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// previewNamespace returns the name of the namespace of the preview of pull
+// request 'number' of 'owner/repo', like 'preview-website-1a2b3c-42'. The
+// short hash of 'owner/repo' tells repositories of the same name apart.
+func previewNamespace(owner, repo string, number int) string {
+	hash := sha256.Sum256([]byte(owner + "/" + repo))
+	suffix := fmt.Sprintf("-%x-%d", hash[:3], number)
+	name := "preview-" + invalidNameChars.ReplaceAllString(strings.ToLower(repo), "-")
+	if len(name)+len(suffix) > 63 {
+		name = name[:63-len(suffix)]
+	}
+	return strings.TrimRight(name, "-") + suffix
+}
+
+// sync creates the namespace of the preview of 'pr' and applies the
+// manifests to it when the head moved since they were applied last. The
+// preview URLs are posted as a Comment, which is updated on every push.
+func (p *previewConfig) sync(owner, repo string, pr *github.PullRequest) error {
+	name := previewNamespace(owner, repo, pr.GetNumber())
+	namespaces := kubeClient.CoreV1().Namespaces()
+	namespace, err := namespaces.Get(name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		namespace, err = namespaces.Create(&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					previewOwnerLabel:      owner,
+					previewRepositoryLabel: repo,
+					previewNumberLabel:     strconv.Itoa(pr.GetNumber()),
+				},
+			},
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("error creating namespace %s: %w", name, err)
+	}
+	if namespace.DeletionTimestamp != nil {
+		// still being torn down, try again later.
+		return fmt.Errorf("namespace %s is terminating", name)
+	}
+
+	sha := pr.GetHead().GetSHA()
+	if namespace.Annotations[previewSHAAnnotation] == sha {
+		return nil
+	}
+	if ref := pr.GetHead().GetRef(); !validRef.MatchString(ref) {
+		return fmt.Errorf("not applying preview of %s/%s#%d, its head ref %q has characters other than letters, digits and ._/-", owner, repo, pr.GetNumber(), ref)
+	}
+
+	data := previewData{
+		Owner:      owner,
+		Repository: repo,
+		Number:     pr.GetNumber(),
+		HeadSHA:    sha,
+		Branch:     pr.GetHead().GetRef(),
+		Namespace:  name,
+	}
+	urls, err := p.apply(data)
+	if err != nil {
+		return fmt.Errorf("error applying manifests to %s: %w", name, err)
+	}
+	klog.InfoS("Applied preview", "pullRequest", fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber()), "sha", sha, "namespace", name)
+
+	if err := p.postURLs(data, urls); err != nil {
+		return err
+	}
+
+	if namespace.Annotations == nil {
+		namespace.Annotations = map[string]string{}
+	}
+	namespace.Annotations[previewSHAAnnotation] = sha
+	if _, err := namespaces.Update(namespace); err != nil {
+		return fmt.Errorf("error saving update to namespace %s: %w", name, err)
+	}
+	return nil
+}
+
+// apply renders the manifests for 'data' and creates or updates the
+// objects. It returns the URLs of the Ingresses among them.
+func (p *previewConfig) apply(data previewData) ([]string, error) {
+	var urls []string
+	for _, manifest := range p.manifests {
+		var buf bytes.Buffer
+		if err := manifest.Execute(&buf, data); err != nil {
+			return nil, err
+		}
+		for _, doc := range documentSeparator.Split(buf.String(), -1) {
+			if strings.TrimSpace(doc) == "" {
+				continue
+			}
+			object, err := p.applyDocument(data.Namespace, []byte(doc))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", manifest.Name(), err)
+			}
+			if object.GetKind() == "Ingress" {
+				rules, _, _ := unstructured.NestedSlice(object.Object, "spec", "rules")
+				for _, rule := range rules {
+					if host, ok := rule.(map[string]interface{})["host"].(string); ok && host != "" {
+						urls = append(urls, "https://"+host)
+					}
+				}
+			}
+		}
+	}
+	return urls, nil
+}
+
+// applyDocument creates or replaces the object in the YAML document 'doc'.
+// Namespaced objects are created in 'namespace'.
+func (p *previewConfig) applyDocument(namespace string, doc []byte) (*unstructured.Unstructured, error) {
+	data, err := yaml.YAMLToJSON(doc)
+	if err != nil {
+		return nil, err
+	}
+	object := &unstructured.Unstructured{}
+	if err := object.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+
+	gvk := object.GroupVersionKind()
+	mapping, err := p.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		// the resource may have been added since discovery was cached.
+		p.mapper.Reset()
+		return nil, err
+	}
+	resource := dynamicClient.Resource(mapping.Resource)
+	client := resource.Namespace("")
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		object.SetNamespace(namespace)
+		client = resource.Namespace(namespace)
+	}
+
+	existing, err := client.Get(object.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return client.Create(object, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	object.SetResourceVersion(existing.GetResourceVersion())
+	return client.Update(object, metav1.UpdateOptions{})
+}
+
+// postURLs creates or updates the Comment listing the URLs of the preview.
+func (p *previewConfig) postURLs(data previewData, urls []string) error {
+	message := fmt.Sprintf(":rocket: Preview of %s deployed to namespace `%s`.\n", data.HeadSHA, data.Namespace)
+	for _, url := range urls {
+		message += fmt.Sprintf("\n- %s", url)
+	}
+
+	comments := cl.GithubV1().Comments(data.Namespace)
+	spec := v1.CommentSpec{Message: message, Owner: data.Owner, Repository: data.Repository, Number: data.Number}
+	comment, err := comments.Get(previewCommentName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = comments.Create(&v1.Comment{
+			ObjectMeta: metav1.ObjectMeta{Name: previewCommentName, Labels: commentLabels(nil)},
+			Spec:       spec,
+		})
+	} else if err == nil && comment.Spec != spec {
+		comment.Spec = spec
+		_, err = comments.Update(comment)
+	}
+	if err != nil {
+		return fmt.Errorf("error saving preview Comment in %s: %w", data.Namespace, err)
+	}
+	return nil
+}
+
+// tearDown deletes the preview namespace 'namespace'. The comment with the
+// preview URLs is edited to say so, as its Comment goes away with the
+// namespace.
+func (p *previewConfig) tearDown(namespace *corev1.Namespace) error {
+	comment, err := cl.GithubV1().Comments(namespace.Name).Get(previewCommentName, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if err == nil && comment.Status.CommentID != 0 {
+		message := fmt.Sprintf(":wastebasket: Preview in namespace `%s` was torn down.", namespace.Name)
+		_, _, err := githubClient.Issues.EditComment(ctx, comment.Spec.Owner, comment.Spec.Repository, comment.Status.CommentID, &github.IssueComment{Body: &message})
+		if err != nil {
+			return fmt.Errorf("error editing comment %d: %w", comment.Status.CommentID, err)
+		}
+	}
+
+	if err := kubeClient.CoreV1().Namespaces().Delete(namespace.Name, &metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("error deleting namespace %s: %w", namespace.Name, err)
+	}
+	klog.InfoS("Tore down preview", "namespace", namespace.Name)
+	return nil
+}
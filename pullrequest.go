@@ -0,0 +1,272 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/github"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+)
+
+// Review decisions reported in PullRequest status. They mirror the values
+// of the reviewDecision field of Github's GraphQL API.
+const (
+	reviewApproved         = "APPROVED"
+	reviewChangesRequested = "CHANGES_REQUESTED"
+	reviewRequired         = "REVIEW_REQUIRED"
+)
+
+// pullRequestPollInterval is how often open pull requests are checked for
+// merges, reviews and changes of their mergeable state.
+const pullRequestPollInterval = time.Minute * 2
+
+var pullRequestQueue = newQueue("pullrequests")
+
+// processPullRequest retrieves the latest version of the PullRequest
+// 'namespace/name' from the cache and syncs it.
+func processPullRequest(namespace, name string) error {
+	obj, err := informersFor(namespace).Github().V1().PullRequests().Lister().PullRequests(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		// deleted, the pull request on Github is kept.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting object '%s/%s' from api: %w", namespace, name, err)
+	}
+
+	// never modify objects from the cache, they are shared with every other
+	// consumer of the informer.
+	return syncPullRequest(obj.DeepCopy())
+}
+
+// syncPullRequest opens the pull request described by 'pr' if it does not
+// exist yet, keeps its title, body, reviewers and labels in sync while it is
+// open, and records what Github reports about it in the resource status.
+// Open pull requests are checked again every pullRequestPollInterval, as
+// nothing tells us when they are reviewed or merged on Github.
+func syncPullRequest(pr *v1.PullRequest) error {
+	spec := pr.Spec
+
+	var (
+		ghPR *github.PullRequest
+		err  error
+	)
+	if pr.Status.Number == 0 {
+		ghPR, err = findOrOpenPullRequest(spec)
+	} else {
+		ghPR, _, err = githubClient.PullRequests.Get(ctx, spec.Owner, spec.Repository, pr.Status.Number)
+	}
+	if err != nil {
+		return fmt.Errorf("error getting pull request for '%s/%s': %w", pr.Namespace, pr.Name, err)
+	}
+	number := ghPR.GetNumber()
+
+	reviews, err := listReviews(spec.Owner, spec.Repository, number)
+	if err != nil {
+		return fmt.Errorf("error listing reviews of %s/%s#%d: %w", spec.Owner, spec.Repository, number, err)
+	}
+	required, err := requiredReviews(spec.Owner, spec.Repository, ghPR.GetBase().GetRef())
+	if err != nil {
+		return fmt.Errorf("error getting the reviews required on %s/%s#%d: %w", spec.Owner, spec.Repository, number, err)
+	}
+
+	// closed and merged pull requests are left alone, we only keep
+	// reporting their state.
+	if ghPR.GetState() == "open" {
+		if ghPR.GetTitle() != spec.Title || ghPR.GetBody() != spec.Body {
+			edit := &github.PullRequest{Title: &spec.Title, Body: &spec.Body}
+			if ghPR, _, err = githubClient.PullRequests.Edit(ctx, spec.Owner, spec.Repository, number, edit); err != nil {
+				return fmt.Errorf("error editing %s/%s#%d: %w", spec.Owner, spec.Repository, number, err)
+			}
+			klog.InfoS("Updated title and body of pull request", "pullRequest", klog.KObj(pr), "number", number)
+		}
+
+		if missing := missingReviewers(spec.Reviewers, ghPR, reviews); len(missing) > 0 {
+			request := github.ReviewersRequest{Reviewers: missing}
+			if _, _, err := githubClient.PullRequests.RequestReviewers(ctx, spec.Owner, spec.Repository, number, request); err != nil {
+				return fmt.Errorf("error requesting reviewers on %s/%s#%d: %w", spec.Owner, spec.Repository, number, err)
+			}
+			klog.InfoS("Requested reviews", "pullRequest", klog.KObj(pr), "number", number, "reviewers", missing)
+		}
+
+		if missing := missingLabels(spec.Labels, ghPR.Labels); len(missing) > 0 {
+			if _, _, err := githubClient.Issues.AddLabelsToIssue(ctx, spec.Owner, spec.Repository, number, missing); err != nil {
+				return fmt.Errorf("error labeling %s/%s#%d: %w", spec.Owner, spec.Repository, number, err)
+			}
+		}
+	}
+
+	status := v1.PullRequestStatus{
+		Number:         number,
+		URL:            ghPR.GetHTMLURL(),
+		State:          ghPR.GetState(),
+		MergeableState: ghPR.GetMergeableState(),
+		ReviewDecision: reviewDecision(reviews, required),
+		Merged:         ghPR.GetMerged(),
+	}
+	if !reflect.DeepEqual(status, pr.Status) {
+		pr.Status = status
+		if _, err := cl.GithubV1().PullRequests(pr.Namespace).Update(pr); err != nil {
+			return fmt.Errorf("error saving update to PullRequest resource: %w", err)
+		}
+		klog.V(2).InfoS("Saved status of PullRequest", "pullRequest", klog.KObj(pr))
+	}
+
+	// resyncs do not help here as they are filtered out when nothing
+	// changed on our side.
+	if status.State == "open" {
+		key, err := cache.MetaNamespaceKeyFunc(pr)
+		if err != nil {
+			return err
+		}
+		pullRequestQueue.AddAfter(key, pullRequestPollInterval)
+	}
+	return nil
+}
+
+// findOrOpenPullRequest returns the open pull request from spec.Head into
+// spec.Base, opening a new one if there is none. Looking for an existing one
+// first keeps us from failing forever when a status update was lost after the
+// pull request had been opened.
+func findOrOpenPullRequest(spec v1.PullRequestSpec) (*github.PullRequest, error) {
+	head := spec.Head
+	if !strings.Contains(head, ":") {
+		head = spec.Owner + ":" + head
+	}
+
+	opts := &github.PullRequestListOptions{State: "open", Head: head, Base: spec.Base}
+	existing, _, err := githubClient.PullRequests.List(ctx, spec.Owner, spec.Repository, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		// listed pull requests lack the mergeable state, only a get
+		// returns it.
+		found, _, err := githubClient.PullRequests.Get(ctx, spec.Owner, spec.Repository, existing[0].GetNumber())
+		return found, err
+	}
+
+	newPR := &github.NewPullRequest{
+		Title: &spec.Title,
+		Head:  &spec.Head,
+		Base:  &spec.Base,
+		Body:  &spec.Body,
+		Draft: &spec.Draft,
+	}
+	created, _, err := githubClient.PullRequests.Create(ctx, spec.Owner, spec.Repository, newPR)
+	if err != nil {
+		return nil, err
+	}
+	klog.InfoS("Opened pull request", "url", created.GetHTMLURL())
+	return created, nil
+}
+
+// listReviews returns all reviews submitted on a pull request, oldest first.
+func listReviews(owner, repo string, number int) ([]*github.PullRequestReview, error) {
+	var reviews []*github.PullRequestReview
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := githubClient.PullRequests.ListReviews(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, page...)
+		if resp.NextPage == 0 {
+			return reviews, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// requiredReviews returns the number of approving reviews the protection of
+// 'branch' requires. Unprotected branches, and branches whose protection we
+// are not allowed to read, require none.
+func requiredReviews(owner, repo, branch string) (int, error) {
+	protection, resp, err := githubClient.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if protection.RequiredPullRequestReviews == nil {
+		return 0, nil
+	}
+	return protection.RequiredPullRequestReviews.RequiredApprovingReviewCount, nil
+}
+
+// reviewDecision derives the overall review decision from the latest
+// approving or blocking review of each reviewer, given the number of
+// approvals 'required'. Like Github's, it is empty when no review is
+// required and nobody reviewed yet.
+func reviewDecision(reviews []*github.PullRequestReview, required int) string {
+	latest := map[string]string{}
+	for _, review := range reviews {
+		switch state := review.GetState(); state {
+		case reviewApproved, reviewChangesRequested:
+			latest[review.GetUser().GetLogin()] = state
+		case "DISMISSED":
+			delete(latest, review.GetUser().GetLogin())
+		}
+	}
+
+	approvals := 0
+	for _, state := range latest {
+		if state == reviewChangesRequested {
+			return reviewChangesRequested
+		}
+		approvals++
+	}
+	switch {
+	case approvals > 0 && approvals >= required:
+		return reviewApproved
+	case required > 0:
+		return reviewRequired
+	}
+	return ""
+}
+
+// missingReviewers returns the wanted reviewers that have neither a pending
+// review request nor submitted a review yet. Requesting a review again from
+// someone who already reviewed would dismiss their review on Github.
+func missingReviewers(wanted []string, pr *github.PullRequest, reviews []*github.PullRequestReview) []string {
+	seen := map[string]bool{strings.ToLower(pr.GetUser().GetLogin()): true}
+	for _, user := range pr.RequestedReviewers {
+		seen[strings.ToLower(user.GetLogin())] = true
+	}
+	for _, review := range reviews {
+		seen[strings.ToLower(review.GetUser().GetLogin())] = true
+	}
+
+	var missing []string
+	for _, login := range wanted {
+		if !seen[strings.ToLower(login)] {
+			missing = append(missing, login)
+		}
+	}
+	return missing
+}
+
+// missingLabels returns the wanted labels that are not set yet. Labels that
+// were added by hand are left in place.
+func missingLabels(wanted []string, current []*github.Label) []string {
+	set := map[string]bool{}
+	for _, label := range current {
+		set[label.GetName()] = true
+	}
+
+	var missing []string
+	for _, label := range wanted {
+		if !set[label] {
+			missing = append(missing, label)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/go-github/github"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+)
+
+const defaultCommitMessage = "Update {{.Spec.Path}} from {{.Namespace}}/{{.Name}}"
+
+// repositoryFilePollInterval is how often RepositoryFiles are compared with
+// the repository, to notice commits made by someone else.
+const repositoryFilePollInterval = time.Minute * 5
+
+var repositoryFileQueue = newQueue("repositoryfiles")
+
+// watchRepositoryFileSources re-enqueues RepositoryFiles whenever the
+// ConfigMap holding their content changes. It returns the HasSynced functions
+// of the informers it uses.
+func watchRepositoryFileSources() []cache.InformerSynced {
+	var synced []cache.InformerSynced
+	for _, configMaps := range kubeInformersOf("configmaps") {
+		configMaps.AddEventHandler(sourceHandler(func(namespace, name string) {
+			files, err := informersFor(namespace).Github().V1().RepositoryFiles().Lister().RepositoryFiles(namespace).List(labels.Everything())
+			if err != nil {
+				runtime.HandleError(fmt.Errorf("error listing RepositoryFiles in %q: %w", namespace, err))
+				return
+			}
+			for _, file := range files {
+				if file.Spec.ConfigMapName == name {
+					enqueue(repositoryFileQueue, file)
+				}
+			}
+		}))
+		synced = append(synced, configMaps.HasSynced)
+	}
+
+	return synced
+}
+
+// processRepositoryFile retrieves the latest version of the RepositoryFile
+// 'namespace/name' from the cache and syncs it.
+func processRepositoryFile(namespace, name string) error {
+	obj, err := informersFor(namespace).Github().V1().RepositoryFiles().Lister().RepositoryFiles(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		// deleted, the file on Github is kept.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting object '%s/%s' from api: %w", namespace, name, err)
+	}
+
+	return syncRepositoryFile(obj.DeepCopy())
+}
+
+// syncRepositoryFile commits the desired content of 'file' if the file in the
+// repository differs from it, either directly to the branch or through a pull
+// request. Content found in place of the one we committed last is recorded
+// in status as drift before it is overwritten. Nothing tells us about commits
+// made by someone else, so the file is checked again every
+// repositoryFilePollInterval.
+func syncRepositoryFile(file *v1.RepositoryFile) error {
+	spec := file.Spec
+	content, err := repositoryFileContent(file)
+	if err != nil {
+		return err
+	}
+	desiredSHA := gitBlobSHA(content)
+
+	branch := spec.Branch
+	if branch == "" {
+		repo, _, err := githubClient.Repositories.Get(ctx, spec.Owner, spec.Repository)
+		if err != nil {
+			return fmt.Errorf("error getting repository %s/%s: %w", spec.Owner, spec.Repository, err)
+		}
+		branch = repo.GetDefaultBranch()
+	}
+
+	status := file.Status
+	currentSHA, err := repositoryFileSHA(spec, branch)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case currentSHA == desiredSHA:
+		// nothing to commit, but a pull request we opened may have been
+		// merged in the meantime.
+		status.BlobSHA = desiredSHA
+		status.PullRequestNumber, status.PullRequestURL = 0, ""
+		if spec.PullRequest {
+			status.BaseBlobSHA = currentSHA
+		}
+
+	case spec.PullRequest:
+		// the pull request only covers the head branch, someone else
+		// committing to the base branch is drift too. Our own pull
+		// request being merged is not.
+		if currentSHA != file.Status.BlobSHA {
+			recordDrift(file, branch, currentSHA, file.Status.BaseBlobSHA, &status)
+		}
+		status.BaseBlobSHA = currentSHA
+		if err := proposeRepositoryFile(file, branch, content, &status); err != nil {
+			return err
+		}
+
+	default:
+		recordDrift(file, branch, currentSHA, file.Status.BlobSHA, &status)
+		commit, err := commitRepositoryFile(file, branch, content, currentSHA)
+		if err != nil {
+			return err
+		}
+		status.BlobSHA = desiredSHA
+		status.CommitSHA = commit
+	}
+
+	if !reflect.DeepEqual(status, file.Status) {
+		file.Status = status
+		if _, err := cl.GithubV1().RepositoryFiles(file.Namespace).Update(file); err != nil {
+			return fmt.Errorf("error saving update to RepositoryFile resource: %w", err)
+		}
+		klog.V(2).InfoS("Saved status of RepositoryFile", "repositoryFile", klog.KObj(file))
+	}
+
+	// resyncs do not help here as they are filtered out when nothing
+	// changed on our side.
+	key, err := cache.MetaNamespaceKeyFunc(file)
+	if err != nil {
+		return err
+	}
+	repositoryFileQueue.AddAfter(key, repositoryFilePollInterval)
+	return nil
+}
+
+// proposeRepositoryFile commits the content to the head branch, creating it
+// from 'base' if needed, and makes sure a pull request from it into 'base' is
+// open.
+func proposeRepositoryFile(file *v1.RepositoryFile, base string, content []byte, status *v1.RepositoryFileStatus) error {
+	spec := file.Spec
+	head := spec.HeadBranch
+	if head == "" {
+		head = fmt.Sprintf("kube-custom-controller/%s/%s", file.Namespace, file.Name)
+	}
+
+	if err := ensureBranch(spec.Owner, spec.Repository, head, base); err != nil {
+		return err
+	}
+
+	headSHA, err := repositoryFileSHA(spec, head)
+	if err != nil {
+		return err
+	}
+	desiredSHA := gitBlobSHA(content)
+	if headSHA != desiredSHA {
+		recordDrift(file, head, headSHA, file.Status.BlobSHA, status)
+		commit, err := commitRepositoryFile(file, head, content, headSHA)
+		if err != nil {
+			return err
+		}
+		status.CommitSHA = commit
+	}
+	status.BlobSHA = desiredSHA
+
+	message, err := repositoryFileCommitMessage(file)
+	if err != nil {
+		return err
+	}
+	pr, err := findOrOpenPullRequest(v1.PullRequestSpec{
+		Owner:      spec.Owner,
+		Repository: spec.Repository,
+		Head:       head,
+		Base:       base,
+		Title:      strings.SplitN(message, "\n", 2)[0],
+		Body:       message,
+	})
+	if err != nil {
+		return fmt.Errorf("error opening pull request for '%s/%s': %w", file.Namespace, file.Name, err)
+	}
+	status.PullRequestNumber = pr.GetNumber()
+	status.PullRequestURL = pr.GetHTMLURL()
+	return nil
+}
+
+// recordDrift records in 'status' when 'currentSHA', found on 'branch', is
+// neither 'knownSHA', the content we last committed or saw there, nor
+// missing, i.e. someone else changed the file.
+func recordDrift(file *v1.RepositoryFile, branch, currentSHA, knownSHA string, status *v1.RepositoryFileStatus) {
+	if currentSHA == "" || knownSHA == "" || currentSHA == knownSHA {
+		return
+	}
+	klog.InfoS("Detected drift of repository file", "repositoryFile", klog.KObj(file), "path", file.Spec.Path,
+		"repository", file.Spec.Owner+"/"+file.Spec.Repository, "branch", branch, "foundBlob", currentSHA, "knownBlob", knownSHA)
+	status.DriftedBlobSHA = currentSHA
+	status.DriftDetectedAt = metav1.Now()
+}
+
+// commitRepositoryFile creates or updates the file on 'branch' and returns the
+// SHA of the commit. 'currentSHA' is the blob SHA of the file being replaced,
+// empty if there is none. Github rejects the update if it changed since.
+func commitRepositoryFile(file *v1.RepositoryFile, branch string, content []byte, currentSHA string) (string, error) {
+	spec := file.Spec
+	message, err := repositoryFileCommitMessage(file)
+	if err != nil {
+		return "", err
+	}
+
+	opts := &github.RepositoryContentFileOptions{
+		Message: &message,
+		Content: content,
+		Branch:  &branch,
+	}
+	if spec.AuthorName != "" || spec.AuthorEmail != "" {
+		author := &github.CommitAuthor{Name: &spec.AuthorName, Email: &spec.AuthorEmail}
+		opts.Author = author
+		opts.Committer = author
+	}
+
+	var resp *github.RepositoryContentResponse
+	if currentSHA == "" {
+		resp, _, err = githubClient.Repositories.CreateFile(ctx, spec.Owner, spec.Repository, spec.Path, opts)
+	} else {
+		opts.SHA = &currentSHA
+		resp, _, err = githubClient.Repositories.UpdateFile(ctx, spec.Owner, spec.Repository, spec.Path, opts)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error committing %s to %s/%s@%s: %w", spec.Path, spec.Owner, spec.Repository, branch, err)
+	}
+	klog.InfoS("Committed file", "path", spec.Path, "repository", spec.Owner+"/"+spec.Repository, "branch", branch)
+	return resp.Commit.GetSHA(), nil
+}
+
+// repositoryFileSHA returns the blob SHA of the file on 'branch', or an empty
+// string if it does not exist.
+func repositoryFileSHA(spec v1.RepositoryFileSpec, branch string) (string, error) {
+	opts := &github.RepositoryContentGetOptions{Ref: branch}
+	current, _, resp, err := githubClient.Repositories.GetContents(ctx, spec.Owner, spec.Repository, spec.Path, opts)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		return "", fmt.Errorf("error getting %s from %s/%s@%s: %w", spec.Path, spec.Owner, spec.Repository, branch, err)
+	}
+	if current == nil {
+		return "", fmt.Errorf("%s in %s/%s@%s is a directory", spec.Path, spec.Owner, spec.Repository, branch)
+	}
+	return current.GetSHA(), nil
+}
+
+// ensureBranch creates 'branch' from the tip of 'from' unless it exists.
+func ensureBranch(owner, repo, branch, from string) error {
+	_, resp, err := githubClient.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+	if err == nil {
+		return nil
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("error getting branch %s of %s/%s: %w", branch, owner, repo, err)
+	}
+
+	base, _, err := githubClient.Git.GetRef(ctx, owner, repo, "refs/heads/"+from)
+	if err != nil {
+		return fmt.Errorf("error getting branch %s of %s/%s: %w", from, owner, repo, err)
+	}
+	ref := "refs/heads/" + branch
+	_, _, err = githubClient.Git.CreateRef(ctx, owner, repo, &github.Reference{
+		Ref:    &ref,
+		Object: &github.GitObject{SHA: base.Object.SHA},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating branch %s of %s/%s: %w", branch, owner, repo, err)
+	}
+	return nil
+}
+
+// repositoryFileContent returns the desired content of 'file'.
+func repositoryFileContent(file *v1.RepositoryFile) ([]byte, error) {
+	spec := file.Spec
+	if spec.ConfigMapName == "" {
+		return []byte(spec.Content), nil
+	}
+
+	configMap, err := kubeInformersFor(file.Namespace).Core().V1().ConfigMaps().Lister().ConfigMaps(file.Namespace).Get(spec.ConfigMapName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting configmap '%s/%s': %w", file.Namespace, spec.ConfigMapName, err)
+	}
+	if value, ok := configMap.Data[spec.ConfigMapKey]; ok {
+		return []byte(value), nil
+	}
+	if value, ok := configMap.BinaryData[spec.ConfigMapKey]; ok {
+		return value, nil
+	}
+	return nil, fmt.Errorf("configmap '%s/%s' has no key %q", file.Namespace, spec.ConfigMapName, spec.ConfigMapKey)
+}
+
+// repositoryFileCommitMessage renders the commit message template of 'file'.
+func repositoryFileCommitMessage(file *v1.RepositoryFile) (string, error) {
+	text := file.Spec.CommitMessage
+	if text == "" {
+		text = defaultCommitMessage
+	}
+	tmpl, err := template.New("commitMessage").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("error parsing commit message of '%s/%s': %w", file.Namespace, file.Name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, file); err != nil {
+		return "", fmt.Errorf("error rendering commit message of '%s/%s': %w", file.Namespace, file.Name, err)
+	}
+	return buf.String(), nil
+}
+
+// gitBlobSHA returns the SHA git and Github identify 'content' by, so that we
+// can tell whether a file holds it without downloading the file.
+func gitBlobSHA(content []byte) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "blob %d\x00", len(content))
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
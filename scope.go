@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	factory "github.com/nikhita/kube-custom-controller/pkg/informers/externalversions"
+)
+
+// the informer factories, by the namespace they watch. Without --namespaces
+// there is a single one for metav1.NamespaceAll. Every namespace has
+// factories of its own, so that the controller only needs a Role in each of
+// them.
+var (
+	sharedFactories map[string]factory.SharedInformerFactory
+
+	// kubeInformerFactories provide informers for core resources, like the
+	// Secrets and ConfigMaps published by ActionsSecrets. The selectors of
+	// --label-selector and --field-selector do not apply to them, the
+	// resources our objects refer to are not labelled like them.
+	kubeInformerFactories map[string]kubeinformers.SharedInformerFactory
+
+	// createdLabels are set on the Comments the controller creates, so that
+	// they match --label-selector and are watched.
+	createdLabels labels.Set
+	// watchedFields is the selector of --field-selector.
+	watchedFields fields.Selector
+)
+
+// watchesAllNamespaces reports whether the controller watches every namespace,
+// and may watch cluster scoped resources.
+func watchesAllNamespaces() bool {
+	_, ok := sharedFactories[metav1.NamespaceAll]
+	return ok
+}
+
+// setupInformerFactories creates the informer factories of 'namespaces', or
+// of every namespace if it is empty. The objects of our resources are only
+// watched if they match 'labelSelector' and 'fieldSelector', which must be
+// valid. It fails if the Comments the controller creates can not be labelled
+// to match 'labelSelector'.
+func setupInformerFactories(namespaces []string, labelSelector, fieldSelector string, resync time.Duration) error {
+	var err error
+	if createdLabels, err = selectorLabels(labelSelector); err != nil {
+		return err
+	}
+	if watchedFields, err = fields.ParseSelector(fieldSelector); err != nil {
+		return err
+	}
+
+	tweakListOptions := func(options *metav1.ListOptions) {
+		options.LabelSelector = labelSelector
+		options.FieldSelector = fieldSelector
+	}
+
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+	sharedFactories = map[string]factory.SharedInformerFactory{}
+	kubeInformerFactories = map[string]kubeinformers.SharedInformerFactory{}
+	for _, namespace := range namespaces {
+		sharedFactories[namespace] = factory.NewSharedInformerFactoryWithOptions(cl, resync, factory.WithNamespace(namespace), factory.WithTweakListOptions(tweakListOptions))
+		kubeInformerFactories[namespace] = kubeinformers.NewSharedInformerFactoryWithOptions(kubeClient, resync, kubeinformers.WithNamespace(namespace))
+	}
+	return nil
+}
+
+// selectorLabels returns labels that match the label selector 'selector',
+// taken from its equality, set and existence requirements.
+func selectorLabels(selector string) (labels.Set, error) {
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, err
+	}
+	set := labels.Set{}
+	requirements, _ := parsed.Requirements()
+	for _, requirement := range requirements {
+		switch requirement.Operator() {
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			set[requirement.Key()] = requirement.Values().List()[0]
+		case selection.Exists:
+			set[requirement.Key()] = ""
+		}
+	}
+	if !parsed.Matches(set) {
+		return nil, fmt.Errorf("the Comments the controller creates can not be labelled to match the label selector %q", selector)
+	}
+	return set, nil
+}
+
+// commentLabels returns the labels of a Comment the controller creates,
+// 'extra' along with the ones matching the label selector.
+func commentLabels(extra map[string]string) map[string]string {
+	if len(createdLabels) == 0 && len(extra) == 0 {
+		return nil
+	}
+	merged := map[string]string{}
+	for key, value := range createdLabels {
+		merged[key] = value
+	}
+	for key, value := range extra {
+		merged[key] = value
+	}
+	return merged
+}
+
+// checkWatched returns an error if the Comments the controller creates in
+// 'namespace', given by the flag 'flagName', would not be watched.
+func checkWatched(flagName, namespace string) error {
+	if _, ok := sharedFactories[namespace]; !ok && !watchesAllNamespaces() {
+		return fmt.Errorf("--%s %q is not among the watched namespaces", flagName, namespace)
+	}
+	if !watchedFields.Matches(fields.Set{"metadata.namespace": namespace}) {
+		return fmt.Errorf("--%s %q does not match the field selector %q", flagName, namespace, watchedFields.String())
+	}
+	return nil
+}
+
+// parseNamespaces splits the comma separated 'namespaces', dropping empty
+// and repeated ones.
+func parseNamespaces(namespaces string) []string {
+	var parsed []string
+	seen := map[string]bool{}
+	for _, namespace := range strings.Split(namespaces, ",") {
+		namespace = strings.TrimSpace(namespace)
+		if namespace == "" || seen[namespace] {
+			continue
+		}
+		seen[namespace] = true
+		parsed = append(parsed, namespace)
+	}
+	return parsed
+}
+
+// informersFor returns the informer factory that watches 'namespace', which
+// must be watched. Cluster scoped resources are in the factory of
+// metav1.NamespaceAll.
+func informersFor(namespace string) factory.SharedInformerFactory {
+	if f, ok := sharedFactories[metav1.NamespaceAll]; ok {
+		return f
+	}
+	return sharedFactories[namespace]
+}
+
+// kubeInformersFor returns the core informer factory that watches
+// 'namespace', which must be watched.
+func kubeInformersFor(namespace string) kubeinformers.SharedInformerFactory {
+	if f, ok := kubeInformerFactories[metav1.NamespaceAll]; ok {
+		return f
+	}
+	return kubeInformerFactories[namespace]
+}
+
+// informersOf returns the informer of our resource 'resource', like
+// 'comments', from the factory of every watched namespace.
+func informersOf(resource string) []cache.SharedIndexInformer {
+	var informers []cache.SharedIndexInformer
+	for _, f := range sharedFactories {
+		informer, err := f.ForResource(v1.SchemeGroupVersion.WithResource(resource))
+		if err != nil {
+			klog.ErrorS(err, "Error getting informer", "resource", resource)
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+		informers = append(informers, informer.Informer())
+	}
+	return informers
+}
+
+// kubeInformersOf returns the informer of the core resource 'resource', like
+// 'secrets', from the core factory of every watched namespace.
+func kubeInformersOf(resource string) []cache.SharedIndexInformer {
+	var informers []cache.SharedIndexInformer
+	for _, f := range kubeInformerFactories {
+		informer, err := f.ForResource(corev1.SchemeGroupVersion.WithResource(resource))
+		if err != nil {
+			klog.ErrorS(err, "Error getting informer", "resource", resource)
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+		informers = append(informers, informer.Informer())
+	}
+	return informers
+}
+
+// startInformers starts the informers requested from every factory.
+func startInformers(stopCh <-chan struct{}) {
+	for _, f := range sharedFactories {
+		f.Start(stopCh)
+	}
+	for _, f := range kubeInformerFactories {
+		f.Start(stopCh)
+	}
+}
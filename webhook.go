@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/github"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+	commentcontroller "github.com/nikhita/kube-custom-controller/pkg/controller"
+)
+
+// commentIDIndex indexes Comments by the ID of their comment on Github.
+const commentIDIndex = "commentID"
+
+// githubWebhook receives the webhooks of Github and syncs the changes made
+// to issue comments there back into Comments.
+type githubWebhook struct {
+	// secret is the secret the webhook is configured with. Payloads not
+	// signed with it are rejected.
+	secret []byte
+
+	// mirror enables creating Comments for the comments someone else posts,
+	// in namespace.
+	mirror    bool
+	namespace string
+
+	// login is the user of the API token. Its comments are never mirrored.
+	login string
+}
+
+// newGithubWebhook sets up the Github webhook receiver.
+func newGithubWebhook(secret string, mirror bool, namespace string) (*githubWebhook, error) {
+	login, err := tokenLogin()
+	if err != nil {
+		return nil, err
+	}
+
+	return &githubWebhook{
+		secret:    []byte(secret),
+		mirror:    mirror,
+		namespace: namespace,
+		login:     login,
+	}, nil
+}
+
+// tokenLogin returns the login of the user of the API token.
+func tokenLogin() (string, error) {
+	user, _, err := githubClient.Users.Get(ctx, "")
+	if err != nil {
+		return "", fmt.Errorf("error getting the user of the API token: %w", err)
+	}
+	return user.GetLogin(), nil
+}
+
+func commentIDIndexFunc(obj interface{}) ([]string, error) {
+	comment, ok := obj.(*v1.Comment)
+	if !ok || comment.Status.CommentID == 0 {
+		return nil, nil
+	}
+	return []string{strconv.FormatInt(comment.Status.CommentID, 10)}, nil
+}
+
+// commentsByID returns the Comments of the comment 'id' on Github, in every
+// watched namespace.
+func commentsByID(id int64) ([]interface{}, error) {
+	var objs []interface{}
+	for _, informer := range informersOf("comments") {
+		found, err := informer.GetIndexer().ByIndex(commentIDIndex, strconv.FormatInt(id, 10))
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, found...)
+	}
+	return objs, nil
+}
+
+// ServeHTTP handles one webhook delivery. Deliveries that fail are answered
+// with a server error, so that they show up as failed on Github and can be
+// redelivered.
+func (h *githubWebhook) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// ValidatePayload checks X-Hub-Signature-256 against the secret.
+	payload, err := github.ValidatePayload(req, h.secret)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid signature: %s", err.Error()), http.StatusUnauthorized)
+		return
+	}
+	event, err := github.ParseWebHook(github.WebHookType(req), payload)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error parsing webhook: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	switch event := event.(type) {
+	case *github.IssueCommentEvent:
+		err = h.issueComment(event)
+	case *github.PullRequestEvent:
+		if previews != nil {
+			previews.enqueue(event.GetRepo().GetOwner().GetLogin(), event.GetRepo().GetName())
+		}
+	}
+	if err != nil {
+		klog.ErrorS(err, "Error handling webhook", "deliveryID", github.DeliveryID(req))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// issueComment updates the Comments of a comment that was edited on Github,
+// and flags them when it was deleted. Comments posted by someone else are
+// run as ChatOps commands and mirrored into observed Comments, if enabled.
+func (h *githubWebhook) issueComment(event *github.IssueCommentEvent) error {
+	remote := event.GetComment()
+	if chatOps != nil && event.GetAction() == "created" && remote.GetUser().GetLogin() != h.login {
+		repo := event.GetRepo()
+		chatOps.enqueue(remote.GetID(), repo.GetOwner().GetLogin(), repo.GetName(), event.GetIssue().GetNumber(), remote.GetUser().GetLogin(), remote.GetBody())
+	}
+
+	objs, err := commentsByID(remote.GetID())
+	if err != nil {
+		return err
+	}
+
+	if len(objs) == 0 {
+		if event.GetAction() == "created" && h.mirror && remote.GetUser().GetLogin() != h.login {
+			return h.mirrorComment(event)
+		}
+		return nil
+	}
+
+	for _, obj := range objs {
+		comment := obj.(*v1.Comment).DeepCopy()
+		switch event.GetAction() {
+		case "edited":
+			if comment.Spec.Message == remote.GetBody() {
+				continue
+			}
+			// take over the edit, so that it is not reverted.
+			comment.Spec.Message = remote.GetBody()
+			comment.Status.MessageHash = commentcontroller.MessageHash(remote.GetBody())
+			if comment.Spec.Observed {
+				comment.Status.UpdatedAt = metav1.NewTime(remote.GetUpdatedAt().Time)
+			}
+		case "deleted":
+			if comment.Status.RemoteDeleted {
+				continue
+			}
+			comment.Status.RemoteDeleted = true
+		default:
+			continue
+		}
+		if _, err := cl.GithubV1().Comments(comment.Namespace).Update(comment); err != nil {
+			return fmt.Errorf("error saving update to Comment resource: %w", err)
+		}
+		klog.InfoS("Synced change made on Github", "comment", klog.KObj(comment), "action", event.GetAction())
+		if event.GetAction() == "deleted" {
+			recorder.Eventf(comment, corev1.EventTypeNormal, "Deleted", "Comment %s was deleted on Github by %s", comment.Status.URL, event.GetSender().GetLogin())
+		} else {
+			recorder.Eventf(comment, corev1.EventTypeNormal, "Updated", "Comment %s was edited on Github by %s", comment.Status.URL, event.GetSender().GetLogin())
+		}
+	}
+	return nil
+}
+
+// mirrorComment creates an observed Comment for the comment of 'event'.
+func (h *githubWebhook) mirrorComment(event *github.IssueCommentEvent) error {
+	repo := event.GetRepo()
+	comment := observedComment(h.namespace, repo.GetOwner().GetLogin(), repo.GetName(), event.GetIssue().GetNumber(), event.GetComment())
+	if _, err := cl.GithubV1().Comments(h.namespace).Create(comment); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("error mirroring comment %d: %w", comment.Status.CommentID, err)
+	}
+	klog.InfoS("Mirrored comment", "commentID", comment.Status.CommentID, "author", comment.Status.Author, "comment", klog.KObj(comment))
+	return nil
+}
+
+// observedComment returns the observed Comment mirroring 'remote', a comment
+// on issue 'number' of 'owner/repo'.
+func observedComment(namespace, owner, repo string, number int, remote *github.IssueComment) *v1.Comment {
+	return &v1.Comment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("issuecomment-%d", remote.GetID()),
+			Namespace: namespace,
+			Labels:    commentLabels(nil),
+		},
+		Spec: v1.CommentSpec{
+			Message:    remote.GetBody(),
+			Owner:      owner,
+			Repository: repo,
+			Number:     number,
+			Observed:   true,
+		},
+		Status: v1.CommentStatus{
+			Created:     true,
+			CommentID:   remote.GetID(),
+			URL:         remote.GetHTMLURL(),
+			MessageHash: commentcontroller.MessageHash(remote.GetBody()),
+			Author:      remote.GetUser().GetLogin(),
+			CreatedAt:   metav1.NewTime(remote.GetCreatedAt().Time),
+			UpdatedAt:   metav1.NewTime(remote.GetUpdatedAt().Time),
+		},
+	}
+}
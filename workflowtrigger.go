@@ -0,0 +1,316 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/github"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/nikhita/kube-custom-controller/pkg/apis/github/v1"
+)
+
+const (
+	// workflowRunPollInterval is how often the workflow run started by a
+	// WorkflowTrigger is polled until it completes.
+	workflowRunPollInterval = time.Second * 15
+	// workflowRunTimeout is how long we look for the workflow run after the
+	// event was fired. No run shows up when no workflow listens to it.
+	workflowRunTimeout = time.Minute * 10
+
+	// workflowRunFound is the type of the condition telling whether the
+	// workflow run started by the event was found.
+	workflowRunFound = "WorkflowRunFound"
+)
+
+var workflowTriggerQueue = newQueue("workflowtriggers")
+
+// processWorkflowTrigger retrieves the latest version of the WorkflowTrigger
+// 'namespace/name' from the cache and syncs it.
+func processWorkflowTrigger(namespace, name string) error {
+	obj, err := informersFor(namespace).Github().V1().WorkflowTriggers().Lister().WorkflowTriggers(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		// deleted, there is nothing left to dispatch.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting object '%s/%s' from api: %w", namespace, name, err)
+	}
+
+	return syncWorkflowTrigger(obj.DeepCopy())
+}
+
+// syncWorkflowTrigger fires the event of 'trigger' if it was not fired for
+// the current generation yet, and otherwise follows the workflow run it
+// started until that completes. If no run shows up within
+// workflowRunTimeout, the WorkflowRunFound condition is set to false and the
+// trigger is left alone until its spec changes.
+func syncWorkflowTrigger(trigger *v1.WorkflowTrigger) error {
+	if trigger.Status.ObservedGeneration != trigger.Generation {
+		if err := dispatchWorkflowTrigger(trigger); err != nil {
+			return err
+		}
+	}
+
+	if trigger.Status.RunStatus == "completed" || noWorkflowRunFound(trigger.Status) {
+		return nil
+	}
+
+	status := *trigger.Status.DeepCopy()
+	run, err := findWorkflowRun(trigger)
+	if err != nil {
+		return fmt.Errorf("error looking up workflow run of '%s/%s': %w", trigger.Namespace, trigger.Name, err)
+	}
+	switch {
+	case run != nil:
+		// the run also tells that an interrupted dispatch went through.
+		status.Dispatched = true
+		status.RunID = run.GetID()
+		status.RunURL = run.GetHTMLURL()
+		status.RunStatus = run.GetStatus()
+		status.Conclusion = run.GetConclusion()
+		setWorkflowTriggerCondition(&status, v1.WorkflowTriggerCondition{
+			Type:    workflowRunFound,
+			Status:  "True",
+			Reason:  "RunFound",
+			Message: fmt.Sprintf("Found workflow run %d", status.RunID),
+		})
+
+	case time.Since(status.DispatchedAt.Time) > workflowRunTimeout:
+		klog.InfoS("No workflow run found for dispatched event", "workflowTrigger", klog.KObj(trigger), "timeout", workflowRunTimeout)
+		setWorkflowTriggerCondition(&status, v1.WorkflowTriggerCondition{
+			Type:    workflowRunFound,
+			Status:  "False",
+			Reason:  "NoRunFound",
+			Message: fmt.Sprintf("No workflow run was started by the event within %s", workflowRunTimeout),
+		})
+	}
+
+	if !reflect.DeepEqual(status, trigger.Status) {
+		trigger.Status = status
+		if _, err := cl.GithubV1().WorkflowTriggers(trigger.Namespace).UpdateStatus(trigger); err != nil {
+			return fmt.Errorf("error saving update to WorkflowTrigger resource: %w", err)
+		}
+	}
+
+	// keep polling until the run has completed. Resyncs do not help here as
+	// they are filtered out when nothing changed.
+	if status.RunStatus != "completed" && !noWorkflowRunFound(status) {
+		key, err := cache.MetaNamespaceKeyFunc(trigger)
+		if err != nil {
+			return err
+		}
+		workflowTriggerQueue.AddAfter(key, workflowRunPollInterval)
+	}
+	return nil
+}
+
+// setWorkflowTriggerCondition sets 'condition' in 'status', replacing the
+// one of the same type. The transition time is kept if its status did not
+// change.
+func setWorkflowTriggerCondition(status *v1.WorkflowTriggerStatus, condition v1.WorkflowTriggerCondition) {
+	condition.LastTransitionTime = metav1.Now()
+	for i, existing := range status.Conditions {
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		status.Conditions[i] = condition
+		return
+	}
+	status.Conditions = append(status.Conditions, condition)
+}
+
+// noWorkflowRunFound reports whether we gave up looking for the workflow run
+// of the event last fired.
+func noWorkflowRunFound(status v1.WorkflowTriggerStatus) bool {
+	for _, condition := range status.Conditions {
+		if condition.Type == workflowRunFound {
+			return condition.Status == "False"
+		}
+	}
+	return false
+}
+
+// dispatchWorkflowTrigger fires the event of 'trigger'. The generation is
+// recorded in status before the event is fired, so that the write fails on a
+// conflict instead of firing the event twice when two syncs race, and so that
+// the event is fired at most once per generation. A sync that finds a
+// recorded but undispatched generation was interrupted after the event may
+// have been fired, it does not fire again but waits for the workflow run to
+// show up.
+func dispatchWorkflowTrigger(trigger *v1.WorkflowTrigger) error {
+	spec, previous := trigger.Spec, trigger.Status
+	trigger.Status = v1.WorkflowTriggerStatus{
+		ObservedGeneration: trigger.Generation,
+		DispatchedAt:       metav1.Now(),
+	}
+	if spec.EventType == "" {
+		// the run of a workflow_dispatch is told apart by the commit it
+		// runs on, whether the ref is a branch or a tag.
+		sha, _, err := githubClient.Repositories.GetCommitSHA1(ctx, spec.Owner, spec.Repository, spec.Ref, "")
+		if err != nil {
+			return fmt.Errorf("error resolving ref %q of %s/%s: %w", spec.Ref, spec.Owner, spec.Repository, err)
+		}
+		trigger.Status.HeadSHA = sha
+	}
+	updated, err := cl.GithubV1().WorkflowTriggers(trigger.Namespace).UpdateStatus(trigger)
+	if err != nil {
+		return fmt.Errorf("error saving update to WorkflowTrigger resource: %w", err)
+	}
+	*trigger = *updated
+
+	dispatchedAt, err := dispatchWorkflowEvent(spec)
+	if err != nil {
+		// Github rejected the event, give the generation back so that the
+		// next sync fires it again. If that fails too the trigger is left
+		// undispatched, and fires again once its spec changes.
+		trigger.Status = previous
+		if _, updateErr := cl.GithubV1().WorkflowTriggers(trigger.Namespace).UpdateStatus(trigger); updateErr != nil {
+			klog.ErrorS(updateErr, "Error resetting status of WorkflowTrigger, the event is not fired again for this generation", "workflowTrigger", klog.KObj(trigger))
+		}
+		return fmt.Errorf("error dispatching event for '%s/%s': %w", trigger.Namespace, trigger.Name, err)
+	}
+	klog.InfoS("Dispatched workflow event", "workflowTrigger", klog.KObj(trigger), "generation", trigger.Generation)
+
+	trigger.Status.Dispatched = true
+	if !dispatchedAt.IsZero() {
+		// Github's clock, which the runs are created by.
+		trigger.Status.DispatchedAt = metav1.NewTime(dispatchedAt)
+	}
+	updated, err = cl.GithubV1().WorkflowTriggers(trigger.Namespace).UpdateStatus(trigger)
+	if err != nil {
+		return fmt.Errorf("error saving update to WorkflowTrigger resource: %w", err)
+	}
+	*trigger = *updated
+	return nil
+}
+
+// dispatchWorkflowEvent fires a repository_dispatch when spec.EventType is
+// set, and a workflow_dispatch of spec.Workflow otherwise. It returns the
+// time Github accepted the event at, zero if it did not tell.
+func dispatchWorkflowEvent(spec v1.WorkflowTriggerSpec) (time.Time, error) {
+	var (
+		resp *github.Response
+		err  error
+	)
+	switch {
+	case spec.EventType != "":
+		payload, err := json.Marshal(spec.ClientPayload)
+		if err != nil {
+			return time.Time{}, err
+		}
+		raw := json.RawMessage(payload)
+		_, resp, err = githubClient.Repositories.Dispatch(ctx, spec.Owner, spec.Repository, github.DispatchRequestOptions{
+			EventType:     spec.EventType,
+			ClientPayload: &raw,
+		})
+		if err != nil {
+			return time.Time{}, err
+		}
+
+	case spec.Workflow != "":
+		inputs := map[string]interface{}{}
+		for name, value := range spec.Inputs {
+			inputs[name] = value
+		}
+		event := github.CreateWorkflowDispatchEventRequest{Ref: spec.Ref, Inputs: inputs}
+		resp, err = githubClient.Actions.CreateWorkflowDispatchEventByFileName(ctx, spec.Owner, spec.Repository, spec.Workflow, event)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+	default:
+		return time.Time{}, fmt.Errorf("one of workflow or eventType must be set")
+	}
+
+	date, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return time.Time{}, nil
+	}
+	return date, nil
+}
+
+// findWorkflowRun returns the workflow run started by the event 'trigger'
+// fired, or nil if Github has not created it yet. Dispatch events do not
+// return the run they start, so we look for the earliest run created since
+// the event was fired that it may have started: a run of spec.Workflow, if
+// set, that was named after the event type for a repository_dispatch, or
+// that runs on the commit the ref was resolved to for a workflow_dispatch.
+// Runs recorded by other WorkflowTriggers are skipped.
+func findWorkflowRun(trigger *v1.WorkflowTrigger) (*github.WorkflowRun, error) {
+	spec, status := trigger.Spec, trigger.Status
+	if status.RunID != 0 {
+		run, _, err := githubClient.Actions.GetWorkflowRunByID(ctx, spec.Owner, spec.Repository, status.RunID)
+		return run, err
+	}
+
+	opts := &github.ListWorkflowRunsOptions{
+		Event:       "workflow_dispatch",
+		Created:     ">=" + status.DispatchedAt.UTC().Format(time.RFC3339),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	if spec.EventType != "" {
+		opts.Event = "repository_dispatch"
+	}
+
+	var (
+		runs *github.WorkflowRuns
+		err  error
+	)
+	if spec.Workflow != "" {
+		runs, _, err = githubClient.Actions.ListWorkflowRunsByFileName(ctx, spec.Owner, spec.Repository, spec.Workflow, opts)
+	} else {
+		runs, _, err = githubClient.Actions.ListRepositoryWorkflowRuns(ctx, spec.Owner, spec.Repository, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := claimedWorkflowRuns(trigger)
+	var earliest *github.WorkflowRun
+	for _, run := range runs.WorkflowRuns {
+		if claimed[run.GetID()] {
+			continue
+		}
+		// runs of a repository_dispatch are named after the event type,
+		// unless the workflow sets a run-name.
+		if spec.EventType != "" && run.GetDisplayTitle() != spec.EventType {
+			continue
+		}
+		if spec.EventType == "" && run.GetHeadSHA() != status.HeadSHA {
+			continue
+		}
+		if earliest == nil || run.GetCreatedAt().Before(earliest.GetCreatedAt().Time) {
+			earliest = run
+		}
+	}
+	return earliest, nil
+}
+
+// claimedWorkflowRuns returns the IDs of the workflow runs other
+// WorkflowTriggers of the same repository recorded.
+func claimedWorkflowRuns(trigger *v1.WorkflowTrigger) map[int64]bool {
+	claimed := map[int64]bool{}
+	for _, informer := range informersOf("workflowtriggers") {
+		for _, obj := range informer.GetStore().List() {
+			other, ok := obj.(*v1.WorkflowTrigger)
+			if !ok || other.UID == trigger.UID || other.Status.RunID == 0 {
+				continue
+			}
+			if strings.EqualFold(other.Spec.Owner, trigger.Spec.Owner) && strings.EqualFold(other.Spec.Repository, trigger.Spec.Repository) {
+				claimed[other.Status.RunID] = true
+			}
+		}
+	}
+	return claimed
+}